@@ -26,7 +26,7 @@ const file_admin_proto_rawDesc = "" +
 	"\n" +
 	"\vadmin.proto\x12\x05admin\x1a\n" +
 	"user.proto\x1a\n" +
-	"role.proto\x1a\x0ecustomer.proto\x1a\x10permission.proto\x1a\voauth.proto\x1a\x12organization.proto\x1a\rproduct.proto\x1a\x16product_category.proto\x1a\x0esupplier.proto\x1a\fvendor.proto2\x81\x1e\n" +
+	"role.proto\x1a\x0ecustomer.proto\x1a\x10permission.proto\x1a\voauth.proto\x1a\x12organization.proto\x1a\x17organization_user.proto\x1a\rproduct.proto\x1a\x16product_category.proto\x1a\x0esupplier.proto\x1a\fvendor.proto2\x9d!\n" +
 	"\fAdminService\x12;\n" +
 	"\bRegister\x12\x16.admin.RegisterRequest\x1a\x17.admin.RegisterResponse\x12J\n" +
 	"\rOAuthRegister\x12\x1b.admin.OAuthRegisterRequest\x1a\x1c.admin.OAuthRegisterResponse\x12A\n" +
@@ -64,7 +64,11 @@ const file_admin_proto_rawDesc = "" +
 	"\x0fGetOrganization\x12\x1d.admin.GetOrganizationRequest\x1a\x1e.admin.GetOrganizationResponse\x12Y\n" +
 	"\x12UpdateOrganization\x12 .admin.UpdateOrganizationRequest\x1a!.admin.UpdateOrganizationResponse\x12Y\n" +
 	"\x12DeleteOrganization\x12 .admin.DeleteOrganizationRequest\x1a!.admin.DeleteOrganizationResponse\x12V\n" +
-	"\x11ListOrganizations\x12\x1f.admin.ListOrganizationsRequest\x1a .admin.ListOrganizationsResponse\x12J\n" +
+	"\x11ListOrganizations\x12\x1f.admin.ListOrganizationsRequest\x1a .admin.ListOrganizationsResponse\x12\\\n" +
+	"\x13AddOrganizationUser\x12!.admin.AddOrganizationUserRequest\x1a\".admin.AddOrganizationUserResponse\x12b\n" +
+	"\x15ListOrganizationUsers\x12#.admin.ListOrganizationUsersRequest\x1a$.admin.ListOrganizationUsersResponse\x12q\n" +
+	"\x1aUpdateOrganizationUserRole\x12(.admin.UpdateOrganizationUserRoleRequest\x1a).admin.UpdateOrganizationUserRoleResponse\x12e\n" +
+	"\x16RemoveOrganizationUser\x12$.admin.RemoveOrganizationUserRequest\x1a%.admin.RemoveOrganizationUserResponse\x12J\n" +
 	"\rCreateProduct\x12\x1b.admin.CreateProductRequest\x1a\x1c.admin.CreateProductResponse\x12A\n" +
 	"\n" +
 	"GetProduct\x12\x18.admin.GetProductRequest\x1a\x19.admin.GetProductResponse\x12J\n" +
@@ -88,213 +92,229 @@ const file_admin_proto_rawDesc = "" +
 	"\vListVendors\x12\x19.admin.ListVendorsRequest\x1a\x1a.admin.ListVendorsResponseB\x1eZ\x1cpersacc/api/v1/admin;adminpbb\x06proto3"
 
 var file_admin_proto_goTypes = []any{
-	(*RegisterRequest)(nil),               // 0: admin.RegisterRequest
-	(*OAuthRegisterRequest)(nil),          // 1: admin.OAuthRegisterRequest
-	(*OAuthTokenRequest)(nil),             // 2: admin.OAuthTokenRequest
-	(*OAuthVerifyRequest)(nil),            // 3: admin.OAuthVerifyRequest
-	(*OAuthRefreshRequest)(nil),           // 4: admin.OAuthRefreshRequest
-	(*CreateUserRequest)(nil),             // 5: admin.CreateUserRequest
-	(*GetUserRequest)(nil),                // 6: admin.GetUserRequest
-	(*UpdateUserRequest)(nil),             // 7: admin.UpdateUserRequest
-	(*DeleteUserRequest)(nil),             // 8: admin.DeleteUserRequest
-	(*ListUsersRequest)(nil),              // 9: admin.ListUsersRequest
-	(*CreateCustomerRequest)(nil),         // 10: admin.CreateCustomerRequest
-	(*GetCustomerRequest)(nil),            // 11: admin.GetCustomerRequest
-	(*UpdateCustomerRequest)(nil),         // 12: admin.UpdateCustomerRequest
-	(*DeleteCustomerRequest)(nil),         // 13: admin.DeleteCustomerRequest
-	(*ListCustomersRequest)(nil),          // 14: admin.ListCustomersRequest
-	(*CreateRoleRequest)(nil),             // 15: admin.CreateRoleRequest
-	(*GetRoleRequest)(nil),                // 16: admin.GetRoleRequest
-	(*UpdateRoleRequest)(nil),             // 17: admin.UpdateRoleRequest
-	(*DeleteRoleRequest)(nil),             // 18: admin.DeleteRoleRequest
-	(*ListRolesRequest)(nil),              // 19: admin.ListRolesRequest
-	(*CreatePermissionRequest)(nil),       // 20: admin.CreatePermissionRequest
-	(*GetPermissionRequest)(nil),          // 21: admin.GetPermissionRequest
-	(*UpdatePermissionRequest)(nil),       // 22: admin.UpdatePermissionRequest
-	(*DeletePermissionRequest)(nil),       // 23: admin.DeletePermissionRequest
-	(*ListPermissionsRequest)(nil),        // 24: admin.ListPermissionsRequest
-	(*CreateOrganizationRequest)(nil),     // 25: admin.CreateOrganizationRequest
-	(*GetOrganizationRequest)(nil),        // 26: admin.GetOrganizationRequest
-	(*UpdateOrganizationRequest)(nil),     // 27: admin.UpdateOrganizationRequest
-	(*DeleteOrganizationRequest)(nil),     // 28: admin.DeleteOrganizationRequest
-	(*ListOrganizationsRequest)(nil),      // 29: admin.ListOrganizationsRequest
-	(*CreateProductRequest)(nil),          // 30: admin.CreateProductRequest
-	(*GetProductRequest)(nil),             // 31: admin.GetProductRequest
-	(*UpdateProductRequest)(nil),          // 32: admin.UpdateProductRequest
-	(*DeleteProductRequest)(nil),          // 33: admin.DeleteProductRequest
-	(*ListProductsRequest)(nil),           // 34: admin.ListProductsRequest
-	(*CreateProductCategoryRequest)(nil),  // 35: admin.CreateProductCategoryRequest
-	(*GetProductCategoryRequest)(nil),     // 36: admin.GetProductCategoryRequest
-	(*UpdateProductCategoryRequest)(nil),  // 37: admin.UpdateProductCategoryRequest
-	(*DeleteProductCategoryRequest)(nil),  // 38: admin.DeleteProductCategoryRequest
-	(*ListProductCategoriesRequest)(nil),  // 39: admin.ListProductCategoriesRequest
-	(*CreateSupplierRequest)(nil),         // 40: admin.CreateSupplierRequest
-	(*GetSupplierRequest)(nil),            // 41: admin.GetSupplierRequest
-	(*UpdateSupplierRequest)(nil),         // 42: admin.UpdateSupplierRequest
-	(*DeleteSupplierRequest)(nil),         // 43: admin.DeleteSupplierRequest
-	(*ListSuppliersRequest)(nil),          // 44: admin.ListSuppliersRequest
-	(*CreateVendorRequest)(nil),           // 45: admin.CreateVendorRequest
-	(*GetVendorRequest)(nil),              // 46: admin.GetVendorRequest
-	(*UpdateVendorRequest)(nil),           // 47: admin.UpdateVendorRequest
-	(*DeleteVendorRequest)(nil),           // 48: admin.DeleteVendorRequest
-	(*ListVendorsRequest)(nil),            // 49: admin.ListVendorsRequest
-	(*RegisterResponse)(nil),              // 50: admin.RegisterResponse
-	(*OAuthRegisterResponse)(nil),         // 51: admin.OAuthRegisterResponse
-	(*OAuthTokenResponse)(nil),            // 52: admin.OAuthTokenResponse
-	(*OAuthVerifyResponse)(nil),           // 53: admin.OAuthVerifyResponse
-	(*OAuthRefreshResponse)(nil),          // 54: admin.OAuthRefreshResponse
-	(*CreateUserResponse)(nil),            // 55: admin.CreateUserResponse
-	(*GetUserResponse)(nil),               // 56: admin.GetUserResponse
-	(*UpdateUserResponse)(nil),            // 57: admin.UpdateUserResponse
-	(*DeleteUserResponse)(nil),            // 58: admin.DeleteUserResponse
-	(*ListUsersResponse)(nil),             // 59: admin.ListUsersResponse
-	(*CreateCustomerResponse)(nil),        // 60: admin.CreateCustomerResponse
-	(*GetCustomerResponse)(nil),           // 61: admin.GetCustomerResponse
-	(*UpdateCustomerResponse)(nil),        // 62: admin.UpdateCustomerResponse
-	(*DeleteCustomerResponse)(nil),        // 63: admin.DeleteCustomerResponse
-	(*ListCustomersResponse)(nil),         // 64: admin.ListCustomersResponse
-	(*CreateRoleResponse)(nil),            // 65: admin.CreateRoleResponse
-	(*GetRoleResponse)(nil),               // 66: admin.GetRoleResponse
-	(*UpdateRoleResponse)(nil),            // 67: admin.UpdateRoleResponse
-	(*DeleteRoleResponse)(nil),            // 68: admin.DeleteRoleResponse
-	(*ListRolesResponse)(nil),             // 69: admin.ListRolesResponse
-	(*CreatePermissionResponse)(nil),      // 70: admin.CreatePermissionResponse
-	(*GetPermissionResponse)(nil),         // 71: admin.GetPermissionResponse
-	(*UpdatePermissionResponse)(nil),      // 72: admin.UpdatePermissionResponse
-	(*DeletePermissionResponse)(nil),      // 73: admin.DeletePermissionResponse
-	(*ListPermissionsResponse)(nil),       // 74: admin.ListPermissionsResponse
-	(*CreateOrganizationResponse)(nil),    // 75: admin.CreateOrganizationResponse
-	(*GetOrganizationResponse)(nil),       // 76: admin.GetOrganizationResponse
-	(*UpdateOrganizationResponse)(nil),    // 77: admin.UpdateOrganizationResponse
-	(*DeleteOrganizationResponse)(nil),    // 78: admin.DeleteOrganizationResponse
-	(*ListOrganizationsResponse)(nil),     // 79: admin.ListOrganizationsResponse
-	(*CreateProductResponse)(nil),         // 80: admin.CreateProductResponse
-	(*GetProductResponse)(nil),            // 81: admin.GetProductResponse
-	(*UpdateProductResponse)(nil),         // 82: admin.UpdateProductResponse
-	(*DeleteProductResponse)(nil),         // 83: admin.DeleteProductResponse
-	(*ListProductsResponse)(nil),          // 84: admin.ListProductsResponse
-	(*CreateProductCategoryResponse)(nil), // 85: admin.CreateProductCategoryResponse
-	(*GetProductCategoryResponse)(nil),    // 86: admin.GetProductCategoryResponse
-	(*UpdateProductCategoryResponse)(nil), // 87: admin.UpdateProductCategoryResponse
-	(*DeleteProductCategoryResponse)(nil), // 88: admin.DeleteProductCategoryResponse
-	(*ListProductCategoriesResponse)(nil), // 89: admin.ListProductCategoriesResponse
-	(*CreateSupplierResponse)(nil),        // 90: admin.CreateSupplierResponse
-	(*GetSupplierResponse)(nil),           // 91: admin.GetSupplierResponse
-	(*UpdateSupplierResponse)(nil),        // 92: admin.UpdateSupplierResponse
-	(*DeleteSupplierResponse)(nil),        // 93: admin.DeleteSupplierResponse
-	(*ListSuppliersResponse)(nil),         // 94: admin.ListSuppliersResponse
-	(*CreateVendorResponse)(nil),          // 95: admin.CreateVendorResponse
-	(*GetVendorResponse)(nil),             // 96: admin.GetVendorResponse
-	(*UpdateVendorResponse)(nil),          // 97: admin.UpdateVendorResponse
-	(*DeleteVendorResponse)(nil),          // 98: admin.DeleteVendorResponse
-	(*ListVendorsResponse)(nil),           // 99: admin.ListVendorsResponse
+	(*RegisterRequest)(nil),                    // 0: admin.RegisterRequest
+	(*OAuthRegisterRequest)(nil),               // 1: admin.OAuthRegisterRequest
+	(*OAuthTokenRequest)(nil),                  // 2: admin.OAuthTokenRequest
+	(*OAuthVerifyRequest)(nil),                 // 3: admin.OAuthVerifyRequest
+	(*OAuthRefreshRequest)(nil),                // 4: admin.OAuthRefreshRequest
+	(*CreateUserRequest)(nil),                  // 5: admin.CreateUserRequest
+	(*GetUserRequest)(nil),                     // 6: admin.GetUserRequest
+	(*UpdateUserRequest)(nil),                  // 7: admin.UpdateUserRequest
+	(*DeleteUserRequest)(nil),                  // 8: admin.DeleteUserRequest
+	(*ListUsersRequest)(nil),                   // 9: admin.ListUsersRequest
+	(*CreateCustomerRequest)(nil),              // 10: admin.CreateCustomerRequest
+	(*GetCustomerRequest)(nil),                 // 11: admin.GetCustomerRequest
+	(*UpdateCustomerRequest)(nil),              // 12: admin.UpdateCustomerRequest
+	(*DeleteCustomerRequest)(nil),              // 13: admin.DeleteCustomerRequest
+	(*ListCustomersRequest)(nil),               // 14: admin.ListCustomersRequest
+	(*CreateRoleRequest)(nil),                  // 15: admin.CreateRoleRequest
+	(*GetRoleRequest)(nil),                     // 16: admin.GetRoleRequest
+	(*UpdateRoleRequest)(nil),                  // 17: admin.UpdateRoleRequest
+	(*DeleteRoleRequest)(nil),                  // 18: admin.DeleteRoleRequest
+	(*ListRolesRequest)(nil),                   // 19: admin.ListRolesRequest
+	(*CreatePermissionRequest)(nil),            // 20: admin.CreatePermissionRequest
+	(*GetPermissionRequest)(nil),               // 21: admin.GetPermissionRequest
+	(*UpdatePermissionRequest)(nil),            // 22: admin.UpdatePermissionRequest
+	(*DeletePermissionRequest)(nil),            // 23: admin.DeletePermissionRequest
+	(*ListPermissionsRequest)(nil),             // 24: admin.ListPermissionsRequest
+	(*CreateOrganizationRequest)(nil),          // 25: admin.CreateOrganizationRequest
+	(*GetOrganizationRequest)(nil),             // 26: admin.GetOrganizationRequest
+	(*UpdateOrganizationRequest)(nil),          // 27: admin.UpdateOrganizationRequest
+	(*DeleteOrganizationRequest)(nil),          // 28: admin.DeleteOrganizationRequest
+	(*ListOrganizationsRequest)(nil),           // 29: admin.ListOrganizationsRequest
+	(*AddOrganizationUserRequest)(nil),         // 30: admin.AddOrganizationUserRequest
+	(*ListOrganizationUsersRequest)(nil),       // 31: admin.ListOrganizationUsersRequest
+	(*UpdateOrganizationUserRoleRequest)(nil),  // 32: admin.UpdateOrganizationUserRoleRequest
+	(*RemoveOrganizationUserRequest)(nil),      // 33: admin.RemoveOrganizationUserRequest
+	(*CreateProductRequest)(nil),               // 34: admin.CreateProductRequest
+	(*GetProductRequest)(nil),                  // 35: admin.GetProductRequest
+	(*UpdateProductRequest)(nil),               // 36: admin.UpdateProductRequest
+	(*DeleteProductRequest)(nil),               // 37: admin.DeleteProductRequest
+	(*ListProductsRequest)(nil),                // 38: admin.ListProductsRequest
+	(*CreateProductCategoryRequest)(nil),       // 39: admin.CreateProductCategoryRequest
+	(*GetProductCategoryRequest)(nil),          // 40: admin.GetProductCategoryRequest
+	(*UpdateProductCategoryRequest)(nil),       // 41: admin.UpdateProductCategoryRequest
+	(*DeleteProductCategoryRequest)(nil),       // 42: admin.DeleteProductCategoryRequest
+	(*ListProductCategoriesRequest)(nil),       // 43: admin.ListProductCategoriesRequest
+	(*CreateSupplierRequest)(nil),              // 44: admin.CreateSupplierRequest
+	(*GetSupplierRequest)(nil),                 // 45: admin.GetSupplierRequest
+	(*UpdateSupplierRequest)(nil),              // 46: admin.UpdateSupplierRequest
+	(*DeleteSupplierRequest)(nil),              // 47: admin.DeleteSupplierRequest
+	(*ListSuppliersRequest)(nil),               // 48: admin.ListSuppliersRequest
+	(*CreateVendorRequest)(nil),                // 49: admin.CreateVendorRequest
+	(*GetVendorRequest)(nil),                   // 50: admin.GetVendorRequest
+	(*UpdateVendorRequest)(nil),                // 51: admin.UpdateVendorRequest
+	(*DeleteVendorRequest)(nil),                // 52: admin.DeleteVendorRequest
+	(*ListVendorsRequest)(nil),                 // 53: admin.ListVendorsRequest
+	(*RegisterResponse)(nil),                   // 54: admin.RegisterResponse
+	(*OAuthRegisterResponse)(nil),              // 55: admin.OAuthRegisterResponse
+	(*OAuthTokenResponse)(nil),                 // 56: admin.OAuthTokenResponse
+	(*OAuthVerifyResponse)(nil),                // 57: admin.OAuthVerifyResponse
+	(*OAuthRefreshResponse)(nil),               // 58: admin.OAuthRefreshResponse
+	(*CreateUserResponse)(nil),                 // 59: admin.CreateUserResponse
+	(*GetUserResponse)(nil),                    // 60: admin.GetUserResponse
+	(*UpdateUserResponse)(nil),                 // 61: admin.UpdateUserResponse
+	(*DeleteUserResponse)(nil),                 // 62: admin.DeleteUserResponse
+	(*ListUsersResponse)(nil),                  // 63: admin.ListUsersResponse
+	(*CreateCustomerResponse)(nil),             // 64: admin.CreateCustomerResponse
+	(*GetCustomerResponse)(nil),                // 65: admin.GetCustomerResponse
+	(*UpdateCustomerResponse)(nil),             // 66: admin.UpdateCustomerResponse
+	(*DeleteCustomerResponse)(nil),             // 67: admin.DeleteCustomerResponse
+	(*ListCustomersResponse)(nil),              // 68: admin.ListCustomersResponse
+	(*CreateRoleResponse)(nil),                 // 69: admin.CreateRoleResponse
+	(*GetRoleResponse)(nil),                    // 70: admin.GetRoleResponse
+	(*UpdateRoleResponse)(nil),                 // 71: admin.UpdateRoleResponse
+	(*DeleteRoleResponse)(nil),                 // 72: admin.DeleteRoleResponse
+	(*ListRolesResponse)(nil),                  // 73: admin.ListRolesResponse
+	(*CreatePermissionResponse)(nil),           // 74: admin.CreatePermissionResponse
+	(*GetPermissionResponse)(nil),              // 75: admin.GetPermissionResponse
+	(*UpdatePermissionResponse)(nil),           // 76: admin.UpdatePermissionResponse
+	(*DeletePermissionResponse)(nil),           // 77: admin.DeletePermissionResponse
+	(*ListPermissionsResponse)(nil),            // 78: admin.ListPermissionsResponse
+	(*CreateOrganizationResponse)(nil),         // 79: admin.CreateOrganizationResponse
+	(*GetOrganizationResponse)(nil),            // 80: admin.GetOrganizationResponse
+	(*UpdateOrganizationResponse)(nil),         // 81: admin.UpdateOrganizationResponse
+	(*DeleteOrganizationResponse)(nil),         // 82: admin.DeleteOrganizationResponse
+	(*ListOrganizationsResponse)(nil),          // 83: admin.ListOrganizationsResponse
+	(*AddOrganizationUserResponse)(nil),        // 84: admin.AddOrganizationUserResponse
+	(*ListOrganizationUsersResponse)(nil),      // 85: admin.ListOrganizationUsersResponse
+	(*UpdateOrganizationUserRoleResponse)(nil), // 86: admin.UpdateOrganizationUserRoleResponse
+	(*RemoveOrganizationUserResponse)(nil),     // 87: admin.RemoveOrganizationUserResponse
+	(*CreateProductResponse)(nil),              // 88: admin.CreateProductResponse
+	(*GetProductResponse)(nil),                 // 89: admin.GetProductResponse
+	(*UpdateProductResponse)(nil),              // 90: admin.UpdateProductResponse
+	(*DeleteProductResponse)(nil),              // 91: admin.DeleteProductResponse
+	(*ListProductsResponse)(nil),               // 92: admin.ListProductsResponse
+	(*CreateProductCategoryResponse)(nil),      // 93: admin.CreateProductCategoryResponse
+	(*GetProductCategoryResponse)(nil),         // 94: admin.GetProductCategoryResponse
+	(*UpdateProductCategoryResponse)(nil),      // 95: admin.UpdateProductCategoryResponse
+	(*DeleteProductCategoryResponse)(nil),      // 96: admin.DeleteProductCategoryResponse
+	(*ListProductCategoriesResponse)(nil),      // 97: admin.ListProductCategoriesResponse
+	(*CreateSupplierResponse)(nil),             // 98: admin.CreateSupplierResponse
+	(*GetSupplierResponse)(nil),                // 99: admin.GetSupplierResponse
+	(*UpdateSupplierResponse)(nil),             // 100: admin.UpdateSupplierResponse
+	(*DeleteSupplierResponse)(nil),             // 101: admin.DeleteSupplierResponse
+	(*ListSuppliersResponse)(nil),              // 102: admin.ListSuppliersResponse
+	(*CreateVendorResponse)(nil),               // 103: admin.CreateVendorResponse
+	(*GetVendorResponse)(nil),                  // 104: admin.GetVendorResponse
+	(*UpdateVendorResponse)(nil),               // 105: admin.UpdateVendorResponse
+	(*DeleteVendorResponse)(nil),               // 106: admin.DeleteVendorResponse
+	(*ListVendorsResponse)(nil),                // 107: admin.ListVendorsResponse
 }
 var file_admin_proto_depIdxs = []int32{
-	0,  // 0: admin.AdminService.Register:input_type -> admin.RegisterRequest
-	1,  // 1: admin.AdminService.OAuthRegister:input_type -> admin.OAuthRegisterRequest
-	2,  // 2: admin.AdminService.OAuthToken:input_type -> admin.OAuthTokenRequest
-	3,  // 3: admin.AdminService.OAuthVerify:input_type -> admin.OAuthVerifyRequest
-	4,  // 4: admin.AdminService.OAuthRefresh:input_type -> admin.OAuthRefreshRequest
-	5,  // 5: admin.AdminService.CreateUser:input_type -> admin.CreateUserRequest
-	6,  // 6: admin.AdminService.GetUser:input_type -> admin.GetUserRequest
-	7,  // 7: admin.AdminService.UpdateUser:input_type -> admin.UpdateUserRequest
-	8,  // 8: admin.AdminService.DeleteUser:input_type -> admin.DeleteUserRequest
-	9,  // 9: admin.AdminService.ListUsers:input_type -> admin.ListUsersRequest
-	10, // 10: admin.AdminService.CreateCustomer:input_type -> admin.CreateCustomerRequest
-	11, // 11: admin.AdminService.GetCustomer:input_type -> admin.GetCustomerRequest
-	12, // 12: admin.AdminService.UpdateCustomer:input_type -> admin.UpdateCustomerRequest
-	13, // 13: admin.AdminService.DeleteCustomer:input_type -> admin.DeleteCustomerRequest
-	14, // 14: admin.AdminService.ListCustomers:input_type -> admin.ListCustomersRequest
-	15, // 15: admin.AdminService.CreateRole:input_type -> admin.CreateRoleRequest
-	16, // 16: admin.AdminService.GetRole:input_type -> admin.GetRoleRequest
-	17, // 17: admin.AdminService.UpdateRole:input_type -> admin.UpdateRoleRequest
-	18, // 18: admin.AdminService.DeleteRole:input_type -> admin.DeleteRoleRequest
-	19, // 19: admin.AdminService.ListRoles:input_type -> admin.ListRolesRequest
-	20, // 20: admin.AdminService.CreatePermission:input_type -> admin.CreatePermissionRequest
-	21, // 21: admin.AdminService.GetPermission:input_type -> admin.GetPermissionRequest
-	22, // 22: admin.AdminService.UpdatePermission:input_type -> admin.UpdatePermissionRequest
-	23, // 23: admin.AdminService.DeletePermission:input_type -> admin.DeletePermissionRequest
-	24, // 24: admin.AdminService.ListPermissions:input_type -> admin.ListPermissionsRequest
-	25, // 25: admin.AdminService.CreateOrganization:input_type -> admin.CreateOrganizationRequest
-	26, // 26: admin.AdminService.GetOrganization:input_type -> admin.GetOrganizationRequest
-	27, // 27: admin.AdminService.UpdateOrganization:input_type -> admin.UpdateOrganizationRequest
-	28, // 28: admin.AdminService.DeleteOrganization:input_type -> admin.DeleteOrganizationRequest
-	29, // 29: admin.AdminService.ListOrganizations:input_type -> admin.ListOrganizationsRequest
-	30, // 30: admin.AdminService.CreateProduct:input_type -> admin.CreateProductRequest
-	31, // 31: admin.AdminService.GetProduct:input_type -> admin.GetProductRequest
-	32, // 32: admin.AdminService.UpdateProduct:input_type -> admin.UpdateProductRequest
-	33, // 33: admin.AdminService.DeleteProduct:input_type -> admin.DeleteProductRequest
-	34, // 34: admin.AdminService.ListProducts:input_type -> admin.ListProductsRequest
-	35, // 35: admin.AdminService.CreateProductCategory:input_type -> admin.CreateProductCategoryRequest
-	36, // 36: admin.AdminService.GetProductCategory:input_type -> admin.GetProductCategoryRequest
-	37, // 37: admin.AdminService.UpdateProductCategory:input_type -> admin.UpdateProductCategoryRequest
-	38, // 38: admin.AdminService.DeleteProductCategory:input_type -> admin.DeleteProductCategoryRequest
-	39, // 39: admin.AdminService.ListProductCategories:input_type -> admin.ListProductCategoriesRequest
-	40, // 40: admin.AdminService.CreateSupplier:input_type -> admin.CreateSupplierRequest
-	41, // 41: admin.AdminService.GetSupplier:input_type -> admin.GetSupplierRequest
-	42, // 42: admin.AdminService.UpdateSupplier:input_type -> admin.UpdateSupplierRequest
-	43, // 43: admin.AdminService.DeleteSupplier:input_type -> admin.DeleteSupplierRequest
-	44, // 44: admin.AdminService.ListSuppliers:input_type -> admin.ListSuppliersRequest
-	45, // 45: admin.AdminService.CreateVendor:input_type -> admin.CreateVendorRequest
-	46, // 46: admin.AdminService.GetVendor:input_type -> admin.GetVendorRequest
-	47, // 47: admin.AdminService.UpdateVendor:input_type -> admin.UpdateVendorRequest
-	48, // 48: admin.AdminService.DeleteVendor:input_type -> admin.DeleteVendorRequest
-	49, // 49: admin.AdminService.ListVendors:input_type -> admin.ListVendorsRequest
-	50, // 50: admin.AdminService.Register:output_type -> admin.RegisterResponse
-	51, // 51: admin.AdminService.OAuthRegister:output_type -> admin.OAuthRegisterResponse
-	52, // 52: admin.AdminService.OAuthToken:output_type -> admin.OAuthTokenResponse
-	53, // 53: admin.AdminService.OAuthVerify:output_type -> admin.OAuthVerifyResponse
-	54, // 54: admin.AdminService.OAuthRefresh:output_type -> admin.OAuthRefreshResponse
-	55, // 55: admin.AdminService.CreateUser:output_type -> admin.CreateUserResponse
-	56, // 56: admin.AdminService.GetUser:output_type -> admin.GetUserResponse
-	57, // 57: admin.AdminService.UpdateUser:output_type -> admin.UpdateUserResponse
-	58, // 58: admin.AdminService.DeleteUser:output_type -> admin.DeleteUserResponse
-	59, // 59: admin.AdminService.ListUsers:output_type -> admin.ListUsersResponse
-	60, // 60: admin.AdminService.CreateCustomer:output_type -> admin.CreateCustomerResponse
-	61, // 61: admin.AdminService.GetCustomer:output_type -> admin.GetCustomerResponse
-	62, // 62: admin.AdminService.UpdateCustomer:output_type -> admin.UpdateCustomerResponse
-	63, // 63: admin.AdminService.DeleteCustomer:output_type -> admin.DeleteCustomerResponse
-	64, // 64: admin.AdminService.ListCustomers:output_type -> admin.ListCustomersResponse
-	65, // 65: admin.AdminService.CreateRole:output_type -> admin.CreateRoleResponse
-	66, // 66: admin.AdminService.GetRole:output_type -> admin.GetRoleResponse
-	67, // 67: admin.AdminService.UpdateRole:output_type -> admin.UpdateRoleResponse
-	68, // 68: admin.AdminService.DeleteRole:output_type -> admin.DeleteRoleResponse
-	69, // 69: admin.AdminService.ListRoles:output_type -> admin.ListRolesResponse
-	70, // 70: admin.AdminService.CreatePermission:output_type -> admin.CreatePermissionResponse
-	71, // 71: admin.AdminService.GetPermission:output_type -> admin.GetPermissionResponse
-	72, // 72: admin.AdminService.UpdatePermission:output_type -> admin.UpdatePermissionResponse
-	73, // 73: admin.AdminService.DeletePermission:output_type -> admin.DeletePermissionResponse
-	74, // 74: admin.AdminService.ListPermissions:output_type -> admin.ListPermissionsResponse
-	75, // 75: admin.AdminService.CreateOrganization:output_type -> admin.CreateOrganizationResponse
-	76, // 76: admin.AdminService.GetOrganization:output_type -> admin.GetOrganizationResponse
-	77, // 77: admin.AdminService.UpdateOrganization:output_type -> admin.UpdateOrganizationResponse
-	78, // 78: admin.AdminService.DeleteOrganization:output_type -> admin.DeleteOrganizationResponse
-	79, // 79: admin.AdminService.ListOrganizations:output_type -> admin.ListOrganizationsResponse
-	80, // 80: admin.AdminService.CreateProduct:output_type -> admin.CreateProductResponse
-	81, // 81: admin.AdminService.GetProduct:output_type -> admin.GetProductResponse
-	82, // 82: admin.AdminService.UpdateProduct:output_type -> admin.UpdateProductResponse
-	83, // 83: admin.AdminService.DeleteProduct:output_type -> admin.DeleteProductResponse
-	84, // 84: admin.AdminService.ListProducts:output_type -> admin.ListProductsResponse
-	85, // 85: admin.AdminService.CreateProductCategory:output_type -> admin.CreateProductCategoryResponse
-	86, // 86: admin.AdminService.GetProductCategory:output_type -> admin.GetProductCategoryResponse
-	87, // 87: admin.AdminService.UpdateProductCategory:output_type -> admin.UpdateProductCategoryResponse
-	88, // 88: admin.AdminService.DeleteProductCategory:output_type -> admin.DeleteProductCategoryResponse
-	89, // 89: admin.AdminService.ListProductCategories:output_type -> admin.ListProductCategoriesResponse
-	90, // 90: admin.AdminService.CreateSupplier:output_type -> admin.CreateSupplierResponse
-	91, // 91: admin.AdminService.GetSupplier:output_type -> admin.GetSupplierResponse
-	92, // 92: admin.AdminService.UpdateSupplier:output_type -> admin.UpdateSupplierResponse
-	93, // 93: admin.AdminService.DeleteSupplier:output_type -> admin.DeleteSupplierResponse
-	94, // 94: admin.AdminService.ListSuppliers:output_type -> admin.ListSuppliersResponse
-	95, // 95: admin.AdminService.CreateVendor:output_type -> admin.CreateVendorResponse
-	96, // 96: admin.AdminService.GetVendor:output_type -> admin.GetVendorResponse
-	97, // 97: admin.AdminService.UpdateVendor:output_type -> admin.UpdateVendorResponse
-	98, // 98: admin.AdminService.DeleteVendor:output_type -> admin.DeleteVendorResponse
-	99, // 99: admin.AdminService.ListVendors:output_type -> admin.ListVendorsResponse
-	50, // [50:100] is the sub-list for method output_type
-	0,  // [0:50] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: admin.AdminService.Register:input_type -> admin.RegisterRequest
+	1,   // 1: admin.AdminService.OAuthRegister:input_type -> admin.OAuthRegisterRequest
+	2,   // 2: admin.AdminService.OAuthToken:input_type -> admin.OAuthTokenRequest
+	3,   // 3: admin.AdminService.OAuthVerify:input_type -> admin.OAuthVerifyRequest
+	4,   // 4: admin.AdminService.OAuthRefresh:input_type -> admin.OAuthRefreshRequest
+	5,   // 5: admin.AdminService.CreateUser:input_type -> admin.CreateUserRequest
+	6,   // 6: admin.AdminService.GetUser:input_type -> admin.GetUserRequest
+	7,   // 7: admin.AdminService.UpdateUser:input_type -> admin.UpdateUserRequest
+	8,   // 8: admin.AdminService.DeleteUser:input_type -> admin.DeleteUserRequest
+	9,   // 9: admin.AdminService.ListUsers:input_type -> admin.ListUsersRequest
+	10,  // 10: admin.AdminService.CreateCustomer:input_type -> admin.CreateCustomerRequest
+	11,  // 11: admin.AdminService.GetCustomer:input_type -> admin.GetCustomerRequest
+	12,  // 12: admin.AdminService.UpdateCustomer:input_type -> admin.UpdateCustomerRequest
+	13,  // 13: admin.AdminService.DeleteCustomer:input_type -> admin.DeleteCustomerRequest
+	14,  // 14: admin.AdminService.ListCustomers:input_type -> admin.ListCustomersRequest
+	15,  // 15: admin.AdminService.CreateRole:input_type -> admin.CreateRoleRequest
+	16,  // 16: admin.AdminService.GetRole:input_type -> admin.GetRoleRequest
+	17,  // 17: admin.AdminService.UpdateRole:input_type -> admin.UpdateRoleRequest
+	18,  // 18: admin.AdminService.DeleteRole:input_type -> admin.DeleteRoleRequest
+	19,  // 19: admin.AdminService.ListRoles:input_type -> admin.ListRolesRequest
+	20,  // 20: admin.AdminService.CreatePermission:input_type -> admin.CreatePermissionRequest
+	21,  // 21: admin.AdminService.GetPermission:input_type -> admin.GetPermissionRequest
+	22,  // 22: admin.AdminService.UpdatePermission:input_type -> admin.UpdatePermissionRequest
+	23,  // 23: admin.AdminService.DeletePermission:input_type -> admin.DeletePermissionRequest
+	24,  // 24: admin.AdminService.ListPermissions:input_type -> admin.ListPermissionsRequest
+	25,  // 25: admin.AdminService.CreateOrganization:input_type -> admin.CreateOrganizationRequest
+	26,  // 26: admin.AdminService.GetOrganization:input_type -> admin.GetOrganizationRequest
+	27,  // 27: admin.AdminService.UpdateOrganization:input_type -> admin.UpdateOrganizationRequest
+	28,  // 28: admin.AdminService.DeleteOrganization:input_type -> admin.DeleteOrganizationRequest
+	29,  // 29: admin.AdminService.ListOrganizations:input_type -> admin.ListOrganizationsRequest
+	30,  // 30: admin.AdminService.AddOrganizationUser:input_type -> admin.AddOrganizationUserRequest
+	31,  // 31: admin.AdminService.ListOrganizationUsers:input_type -> admin.ListOrganizationUsersRequest
+	32,  // 32: admin.AdminService.UpdateOrganizationUserRole:input_type -> admin.UpdateOrganizationUserRoleRequest
+	33,  // 33: admin.AdminService.RemoveOrganizationUser:input_type -> admin.RemoveOrganizationUserRequest
+	34,  // 34: admin.AdminService.CreateProduct:input_type -> admin.CreateProductRequest
+	35,  // 35: admin.AdminService.GetProduct:input_type -> admin.GetProductRequest
+	36,  // 36: admin.AdminService.UpdateProduct:input_type -> admin.UpdateProductRequest
+	37,  // 37: admin.AdminService.DeleteProduct:input_type -> admin.DeleteProductRequest
+	38,  // 38: admin.AdminService.ListProducts:input_type -> admin.ListProductsRequest
+	39,  // 39: admin.AdminService.CreateProductCategory:input_type -> admin.CreateProductCategoryRequest
+	40,  // 40: admin.AdminService.GetProductCategory:input_type -> admin.GetProductCategoryRequest
+	41,  // 41: admin.AdminService.UpdateProductCategory:input_type -> admin.UpdateProductCategoryRequest
+	42,  // 42: admin.AdminService.DeleteProductCategory:input_type -> admin.DeleteProductCategoryRequest
+	43,  // 43: admin.AdminService.ListProductCategories:input_type -> admin.ListProductCategoriesRequest
+	44,  // 44: admin.AdminService.CreateSupplier:input_type -> admin.CreateSupplierRequest
+	45,  // 45: admin.AdminService.GetSupplier:input_type -> admin.GetSupplierRequest
+	46,  // 46: admin.AdminService.UpdateSupplier:input_type -> admin.UpdateSupplierRequest
+	47,  // 47: admin.AdminService.DeleteSupplier:input_type -> admin.DeleteSupplierRequest
+	48,  // 48: admin.AdminService.ListSuppliers:input_type -> admin.ListSuppliersRequest
+	49,  // 49: admin.AdminService.CreateVendor:input_type -> admin.CreateVendorRequest
+	50,  // 50: admin.AdminService.GetVendor:input_type -> admin.GetVendorRequest
+	51,  // 51: admin.AdminService.UpdateVendor:input_type -> admin.UpdateVendorRequest
+	52,  // 52: admin.AdminService.DeleteVendor:input_type -> admin.DeleteVendorRequest
+	53,  // 53: admin.AdminService.ListVendors:input_type -> admin.ListVendorsRequest
+	54,  // 54: admin.AdminService.Register:output_type -> admin.RegisterResponse
+	55,  // 55: admin.AdminService.OAuthRegister:output_type -> admin.OAuthRegisterResponse
+	56,  // 56: admin.AdminService.OAuthToken:output_type -> admin.OAuthTokenResponse
+	57,  // 57: admin.AdminService.OAuthVerify:output_type -> admin.OAuthVerifyResponse
+	58,  // 58: admin.AdminService.OAuthRefresh:output_type -> admin.OAuthRefreshResponse
+	59,  // 59: admin.AdminService.CreateUser:output_type -> admin.CreateUserResponse
+	60,  // 60: admin.AdminService.GetUser:output_type -> admin.GetUserResponse
+	61,  // 61: admin.AdminService.UpdateUser:output_type -> admin.UpdateUserResponse
+	62,  // 62: admin.AdminService.DeleteUser:output_type -> admin.DeleteUserResponse
+	63,  // 63: admin.AdminService.ListUsers:output_type -> admin.ListUsersResponse
+	64,  // 64: admin.AdminService.CreateCustomer:output_type -> admin.CreateCustomerResponse
+	65,  // 65: admin.AdminService.GetCustomer:output_type -> admin.GetCustomerResponse
+	66,  // 66: admin.AdminService.UpdateCustomer:output_type -> admin.UpdateCustomerResponse
+	67,  // 67: admin.AdminService.DeleteCustomer:output_type -> admin.DeleteCustomerResponse
+	68,  // 68: admin.AdminService.ListCustomers:output_type -> admin.ListCustomersResponse
+	69,  // 69: admin.AdminService.CreateRole:output_type -> admin.CreateRoleResponse
+	70,  // 70: admin.AdminService.GetRole:output_type -> admin.GetRoleResponse
+	71,  // 71: admin.AdminService.UpdateRole:output_type -> admin.UpdateRoleResponse
+	72,  // 72: admin.AdminService.DeleteRole:output_type -> admin.DeleteRoleResponse
+	73,  // 73: admin.AdminService.ListRoles:output_type -> admin.ListRolesResponse
+	74,  // 74: admin.AdminService.CreatePermission:output_type -> admin.CreatePermissionResponse
+	75,  // 75: admin.AdminService.GetPermission:output_type -> admin.GetPermissionResponse
+	76,  // 76: admin.AdminService.UpdatePermission:output_type -> admin.UpdatePermissionResponse
+	77,  // 77: admin.AdminService.DeletePermission:output_type -> admin.DeletePermissionResponse
+	78,  // 78: admin.AdminService.ListPermissions:output_type -> admin.ListPermissionsResponse
+	79,  // 79: admin.AdminService.CreateOrganization:output_type -> admin.CreateOrganizationResponse
+	80,  // 80: admin.AdminService.GetOrganization:output_type -> admin.GetOrganizationResponse
+	81,  // 81: admin.AdminService.UpdateOrganization:output_type -> admin.UpdateOrganizationResponse
+	82,  // 82: admin.AdminService.DeleteOrganization:output_type -> admin.DeleteOrganizationResponse
+	83,  // 83: admin.AdminService.ListOrganizations:output_type -> admin.ListOrganizationsResponse
+	84,  // 84: admin.AdminService.AddOrganizationUser:output_type -> admin.AddOrganizationUserResponse
+	85,  // 85: admin.AdminService.ListOrganizationUsers:output_type -> admin.ListOrganizationUsersResponse
+	86,  // 86: admin.AdminService.UpdateOrganizationUserRole:output_type -> admin.UpdateOrganizationUserRoleResponse
+	87,  // 87: admin.AdminService.RemoveOrganizationUser:output_type -> admin.RemoveOrganizationUserResponse
+	88,  // 88: admin.AdminService.CreateProduct:output_type -> admin.CreateProductResponse
+	89,  // 89: admin.AdminService.GetProduct:output_type -> admin.GetProductResponse
+	90,  // 90: admin.AdminService.UpdateProduct:output_type -> admin.UpdateProductResponse
+	91,  // 91: admin.AdminService.DeleteProduct:output_type -> admin.DeleteProductResponse
+	92,  // 92: admin.AdminService.ListProducts:output_type -> admin.ListProductsResponse
+	93,  // 93: admin.AdminService.CreateProductCategory:output_type -> admin.CreateProductCategoryResponse
+	94,  // 94: admin.AdminService.GetProductCategory:output_type -> admin.GetProductCategoryResponse
+	95,  // 95: admin.AdminService.UpdateProductCategory:output_type -> admin.UpdateProductCategoryResponse
+	96,  // 96: admin.AdminService.DeleteProductCategory:output_type -> admin.DeleteProductCategoryResponse
+	97,  // 97: admin.AdminService.ListProductCategories:output_type -> admin.ListProductCategoriesResponse
+	98,  // 98: admin.AdminService.CreateSupplier:output_type -> admin.CreateSupplierResponse
+	99,  // 99: admin.AdminService.GetSupplier:output_type -> admin.GetSupplierResponse
+	100, // 100: admin.AdminService.UpdateSupplier:output_type -> admin.UpdateSupplierResponse
+	101, // 101: admin.AdminService.DeleteSupplier:output_type -> admin.DeleteSupplierResponse
+	102, // 102: admin.AdminService.ListSuppliers:output_type -> admin.ListSuppliersResponse
+	103, // 103: admin.AdminService.CreateVendor:output_type -> admin.CreateVendorResponse
+	104, // 104: admin.AdminService.GetVendor:output_type -> admin.GetVendorResponse
+	105, // 105: admin.AdminService.UpdateVendor:output_type -> admin.UpdateVendorResponse
+	106, // 106: admin.AdminService.DeleteVendor:output_type -> admin.DeleteVendorResponse
+	107, // 107: admin.AdminService.ListVendors:output_type -> admin.ListVendorsResponse
+	54,  // [54:108] is the sub-list for method output_type
+	0,   // [0:54] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_admin_proto_init() }
@@ -308,6 +328,7 @@ func file_admin_proto_init() {
 	file_permission_proto_init()
 	file_oauth_proto_init()
 	file_organization_proto_init()
+	file_organization_user_proto_init()
 	file_product_proto_init()
 	file_product_category_proto_init()
 	file_supplier_proto_init()
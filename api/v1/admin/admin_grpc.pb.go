@@ -19,56 +19,60 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AdminService_Register_FullMethodName              = "/admin.AdminService/Register"
-	AdminService_OAuthRegister_FullMethodName         = "/admin.AdminService/OAuthRegister"
-	AdminService_OAuthToken_FullMethodName            = "/admin.AdminService/OAuthToken"
-	AdminService_OAuthVerify_FullMethodName           = "/admin.AdminService/OAuthVerify"
-	AdminService_OAuthRefresh_FullMethodName          = "/admin.AdminService/OAuthRefresh"
-	AdminService_CreateUser_FullMethodName            = "/admin.AdminService/CreateUser"
-	AdminService_GetUser_FullMethodName               = "/admin.AdminService/GetUser"
-	AdminService_UpdateUser_FullMethodName            = "/admin.AdminService/UpdateUser"
-	AdminService_DeleteUser_FullMethodName            = "/admin.AdminService/DeleteUser"
-	AdminService_ListUsers_FullMethodName             = "/admin.AdminService/ListUsers"
-	AdminService_CreateCustomer_FullMethodName        = "/admin.AdminService/CreateCustomer"
-	AdminService_GetCustomer_FullMethodName           = "/admin.AdminService/GetCustomer"
-	AdminService_UpdateCustomer_FullMethodName        = "/admin.AdminService/UpdateCustomer"
-	AdminService_DeleteCustomer_FullMethodName        = "/admin.AdminService/DeleteCustomer"
-	AdminService_ListCustomers_FullMethodName         = "/admin.AdminService/ListCustomers"
-	AdminService_CreateRole_FullMethodName            = "/admin.AdminService/CreateRole"
-	AdminService_GetRole_FullMethodName               = "/admin.AdminService/GetRole"
-	AdminService_UpdateRole_FullMethodName            = "/admin.AdminService/UpdateRole"
-	AdminService_DeleteRole_FullMethodName            = "/admin.AdminService/DeleteRole"
-	AdminService_ListRoles_FullMethodName             = "/admin.AdminService/ListRoles"
-	AdminService_CreatePermission_FullMethodName      = "/admin.AdminService/CreatePermission"
-	AdminService_GetPermission_FullMethodName         = "/admin.AdminService/GetPermission"
-	AdminService_UpdatePermission_FullMethodName      = "/admin.AdminService/UpdatePermission"
-	AdminService_DeletePermission_FullMethodName      = "/admin.AdminService/DeletePermission"
-	AdminService_ListPermissions_FullMethodName       = "/admin.AdminService/ListPermissions"
-	AdminService_CreateOrganization_FullMethodName    = "/admin.AdminService/CreateOrganization"
-	AdminService_GetOrganization_FullMethodName       = "/admin.AdminService/GetOrganization"
-	AdminService_UpdateOrganization_FullMethodName    = "/admin.AdminService/UpdateOrganization"
-	AdminService_DeleteOrganization_FullMethodName    = "/admin.AdminService/DeleteOrganization"
-	AdminService_ListOrganizations_FullMethodName     = "/admin.AdminService/ListOrganizations"
-	AdminService_CreateProduct_FullMethodName         = "/admin.AdminService/CreateProduct"
-	AdminService_GetProduct_FullMethodName            = "/admin.AdminService/GetProduct"
-	AdminService_UpdateProduct_FullMethodName         = "/admin.AdminService/UpdateProduct"
-	AdminService_DeleteProduct_FullMethodName         = "/admin.AdminService/DeleteProduct"
-	AdminService_ListProducts_FullMethodName          = "/admin.AdminService/ListProducts"
-	AdminService_CreateProductCategory_FullMethodName = "/admin.AdminService/CreateProductCategory"
-	AdminService_GetProductCategory_FullMethodName    = "/admin.AdminService/GetProductCategory"
-	AdminService_UpdateProductCategory_FullMethodName = "/admin.AdminService/UpdateProductCategory"
-	AdminService_DeleteProductCategory_FullMethodName = "/admin.AdminService/DeleteProductCategory"
-	AdminService_ListProductCategories_FullMethodName = "/admin.AdminService/ListProductCategories"
-	AdminService_CreateSupplier_FullMethodName        = "/admin.AdminService/CreateSupplier"
-	AdminService_GetSupplier_FullMethodName           = "/admin.AdminService/GetSupplier"
-	AdminService_UpdateSupplier_FullMethodName        = "/admin.AdminService/UpdateSupplier"
-	AdminService_DeleteSupplier_FullMethodName        = "/admin.AdminService/DeleteSupplier"
-	AdminService_ListSuppliers_FullMethodName         = "/admin.AdminService/ListSuppliers"
-	AdminService_CreateVendor_FullMethodName          = "/admin.AdminService/CreateVendor"
-	AdminService_GetVendor_FullMethodName             = "/admin.AdminService/GetVendor"
-	AdminService_UpdateVendor_FullMethodName          = "/admin.AdminService/UpdateVendor"
-	AdminService_DeleteVendor_FullMethodName          = "/admin.AdminService/DeleteVendor"
-	AdminService_ListVendors_FullMethodName           = "/admin.AdminService/ListVendors"
+	AdminService_Register_FullMethodName                   = "/admin.AdminService/Register"
+	AdminService_OAuthRegister_FullMethodName              = "/admin.AdminService/OAuthRegister"
+	AdminService_OAuthToken_FullMethodName                 = "/admin.AdminService/OAuthToken"
+	AdminService_OAuthVerify_FullMethodName                = "/admin.AdminService/OAuthVerify"
+	AdminService_OAuthRefresh_FullMethodName               = "/admin.AdminService/OAuthRefresh"
+	AdminService_CreateUser_FullMethodName                 = "/admin.AdminService/CreateUser"
+	AdminService_GetUser_FullMethodName                    = "/admin.AdminService/GetUser"
+	AdminService_UpdateUser_FullMethodName                 = "/admin.AdminService/UpdateUser"
+	AdminService_DeleteUser_FullMethodName                 = "/admin.AdminService/DeleteUser"
+	AdminService_ListUsers_FullMethodName                  = "/admin.AdminService/ListUsers"
+	AdminService_CreateCustomer_FullMethodName             = "/admin.AdminService/CreateCustomer"
+	AdminService_GetCustomer_FullMethodName                = "/admin.AdminService/GetCustomer"
+	AdminService_UpdateCustomer_FullMethodName             = "/admin.AdminService/UpdateCustomer"
+	AdminService_DeleteCustomer_FullMethodName             = "/admin.AdminService/DeleteCustomer"
+	AdminService_ListCustomers_FullMethodName              = "/admin.AdminService/ListCustomers"
+	AdminService_CreateRole_FullMethodName                 = "/admin.AdminService/CreateRole"
+	AdminService_GetRole_FullMethodName                    = "/admin.AdminService/GetRole"
+	AdminService_UpdateRole_FullMethodName                 = "/admin.AdminService/UpdateRole"
+	AdminService_DeleteRole_FullMethodName                 = "/admin.AdminService/DeleteRole"
+	AdminService_ListRoles_FullMethodName                  = "/admin.AdminService/ListRoles"
+	AdminService_CreatePermission_FullMethodName           = "/admin.AdminService/CreatePermission"
+	AdminService_GetPermission_FullMethodName              = "/admin.AdminService/GetPermission"
+	AdminService_UpdatePermission_FullMethodName           = "/admin.AdminService/UpdatePermission"
+	AdminService_DeletePermission_FullMethodName           = "/admin.AdminService/DeletePermission"
+	AdminService_ListPermissions_FullMethodName            = "/admin.AdminService/ListPermissions"
+	AdminService_CreateOrganization_FullMethodName         = "/admin.AdminService/CreateOrganization"
+	AdminService_GetOrganization_FullMethodName            = "/admin.AdminService/GetOrganization"
+	AdminService_UpdateOrganization_FullMethodName         = "/admin.AdminService/UpdateOrganization"
+	AdminService_DeleteOrganization_FullMethodName         = "/admin.AdminService/DeleteOrganization"
+	AdminService_ListOrganizations_FullMethodName          = "/admin.AdminService/ListOrganizations"
+	AdminService_AddOrganizationUser_FullMethodName        = "/admin.AdminService/AddOrganizationUser"
+	AdminService_ListOrganizationUsers_FullMethodName      = "/admin.AdminService/ListOrganizationUsers"
+	AdminService_UpdateOrganizationUserRole_FullMethodName = "/admin.AdminService/UpdateOrganizationUserRole"
+	AdminService_RemoveOrganizationUser_FullMethodName     = "/admin.AdminService/RemoveOrganizationUser"
+	AdminService_CreateProduct_FullMethodName              = "/admin.AdminService/CreateProduct"
+	AdminService_GetProduct_FullMethodName                 = "/admin.AdminService/GetProduct"
+	AdminService_UpdateProduct_FullMethodName              = "/admin.AdminService/UpdateProduct"
+	AdminService_DeleteProduct_FullMethodName              = "/admin.AdminService/DeleteProduct"
+	AdminService_ListProducts_FullMethodName               = "/admin.AdminService/ListProducts"
+	AdminService_CreateProductCategory_FullMethodName      = "/admin.AdminService/CreateProductCategory"
+	AdminService_GetProductCategory_FullMethodName         = "/admin.AdminService/GetProductCategory"
+	AdminService_UpdateProductCategory_FullMethodName      = "/admin.AdminService/UpdateProductCategory"
+	AdminService_DeleteProductCategory_FullMethodName      = "/admin.AdminService/DeleteProductCategory"
+	AdminService_ListProductCategories_FullMethodName      = "/admin.AdminService/ListProductCategories"
+	AdminService_CreateSupplier_FullMethodName             = "/admin.AdminService/CreateSupplier"
+	AdminService_GetSupplier_FullMethodName                = "/admin.AdminService/GetSupplier"
+	AdminService_UpdateSupplier_FullMethodName             = "/admin.AdminService/UpdateSupplier"
+	AdminService_DeleteSupplier_FullMethodName             = "/admin.AdminService/DeleteSupplier"
+	AdminService_ListSuppliers_FullMethodName              = "/admin.AdminService/ListSuppliers"
+	AdminService_CreateVendor_FullMethodName               = "/admin.AdminService/CreateVendor"
+	AdminService_GetVendor_FullMethodName                  = "/admin.AdminService/GetVendor"
+	AdminService_UpdateVendor_FullMethodName               = "/admin.AdminService/UpdateVendor"
+	AdminService_DeleteVendor_FullMethodName               = "/admin.AdminService/DeleteVendor"
+	AdminService_ListVendors_FullMethodName                = "/admin.AdminService/ListVendors"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -105,6 +109,10 @@ type AdminServiceClient interface {
 	UpdateOrganization(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*UpdateOrganizationResponse, error)
 	DeleteOrganization(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*DeleteOrganizationResponse, error)
 	ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error)
+	AddOrganizationUser(ctx context.Context, in *AddOrganizationUserRequest, opts ...grpc.CallOption) (*AddOrganizationUserResponse, error)
+	ListOrganizationUsers(ctx context.Context, in *ListOrganizationUsersRequest, opts ...grpc.CallOption) (*ListOrganizationUsersResponse, error)
+	UpdateOrganizationUserRole(ctx context.Context, in *UpdateOrganizationUserRoleRequest, opts ...grpc.CallOption) (*UpdateOrganizationUserRoleResponse, error)
+	RemoveOrganizationUser(ctx context.Context, in *RemoveOrganizationUserRequest, opts ...grpc.CallOption) (*RemoveOrganizationUserResponse, error)
 	CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*CreateProductResponse, error)
 	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductResponse, error)
 	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error)
@@ -435,6 +443,46 @@ func (c *adminServiceClient) ListOrganizations(ctx context.Context, in *ListOrga
 	return out, nil
 }
 
+func (c *adminServiceClient) AddOrganizationUser(ctx context.Context, in *AddOrganizationUserRequest, opts ...grpc.CallOption) (*AddOrganizationUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddOrganizationUserResponse)
+	err := c.cc.Invoke(ctx, AdminService_AddOrganizationUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListOrganizationUsers(ctx context.Context, in *ListOrganizationUsersRequest, opts ...grpc.CallOption) (*ListOrganizationUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrganizationUsersResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListOrganizationUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateOrganizationUserRole(ctx context.Context, in *UpdateOrganizationUserRoleRequest, opts ...grpc.CallOption) (*UpdateOrganizationUserRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateOrganizationUserRoleResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateOrganizationUserRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemoveOrganizationUser(ctx context.Context, in *RemoveOrganizationUserRequest, opts ...grpc.CallOption) (*RemoveOrganizationUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveOrganizationUserResponse)
+	err := c.cc.Invoke(ctx, AdminService_RemoveOrganizationUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*CreateProductResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateProductResponse)
@@ -669,6 +717,10 @@ type AdminServiceServer interface {
 	UpdateOrganization(context.Context, *UpdateOrganizationRequest) (*UpdateOrganizationResponse, error)
 	DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error)
 	ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error)
+	AddOrganizationUser(context.Context, *AddOrganizationUserRequest) (*AddOrganizationUserResponse, error)
+	ListOrganizationUsers(context.Context, *ListOrganizationUsersRequest) (*ListOrganizationUsersResponse, error)
+	UpdateOrganizationUserRole(context.Context, *UpdateOrganizationUserRoleRequest) (*UpdateOrganizationUserRoleResponse, error)
+	RemoveOrganizationUser(context.Context, *RemoveOrganizationUserRequest) (*RemoveOrganizationUserResponse, error)
 	CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error)
 	GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error)
 	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error)
@@ -789,6 +841,18 @@ func (UnimplementedAdminServiceServer) DeleteOrganization(context.Context, *Dele
 func (UnimplementedAdminServiceServer) ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizations not implemented")
 }
+func (UnimplementedAdminServiceServer) AddOrganizationUser(context.Context, *AddOrganizationUserRequest) (*AddOrganizationUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrganizationUser not implemented")
+}
+func (UnimplementedAdminServiceServer) ListOrganizationUsers(context.Context, *ListOrganizationUsersRequest) (*ListOrganizationUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationUsers not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateOrganizationUserRole(context.Context, *UpdateOrganizationUserRoleRequest) (*UpdateOrganizationUserRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganizationUserRole not implemented")
+}
+func (UnimplementedAdminServiceServer) RemoveOrganizationUser(context.Context, *RemoveOrganizationUserRequest) (*RemoveOrganizationUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrganizationUser not implemented")
+}
 func (UnimplementedAdminServiceServer) CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
 }
@@ -1410,6 +1474,78 @@ func _AdminService_ListOrganizations_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_AddOrganizationUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrganizationUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddOrganizationUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AddOrganizationUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddOrganizationUser(ctx, req.(*AddOrganizationUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListOrganizationUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListOrganizationUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListOrganizationUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListOrganizationUsers(ctx, req.(*ListOrganizationUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateOrganizationUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateOrganizationUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateOrganizationUserRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateOrganizationUserRole(ctx, req.(*UpdateOrganizationUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveOrganizationUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrganizationUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveOrganizationUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RemoveOrganizationUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveOrganizationUser(ctx, req.(*RemoveOrganizationUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateProductRequest)
 	if err := dec(in); err != nil {
@@ -1897,6 +2033,22 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListOrganizations",
 			Handler:    _AdminService_ListOrganizations_Handler,
 		},
+		{
+			MethodName: "AddOrganizationUser",
+			Handler:    _AdminService_AddOrganizationUser_Handler,
+		},
+		{
+			MethodName: "ListOrganizationUsers",
+			Handler:    _AdminService_ListOrganizationUsers_Handler,
+		},
+		{
+			MethodName: "UpdateOrganizationUserRole",
+			Handler:    _AdminService_UpdateOrganizationUserRole_Handler,
+		},
+		{
+			MethodName: "RemoveOrganizationUser",
+			Handler:    _AdminService_RemoveOrganizationUser_Handler,
+		},
 		{
 			MethodName: "CreateProduct",
 			Handler:    _AdminService_CreateProduct_Handler,
@@ -9,6 +9,7 @@ package adminpb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -38,6 +39,10 @@ type Customer struct {
 	CreatedAt      string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt      string                 `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	DeletedAt      string                 `protobuf:"bytes,15,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Version        int64                  `protobuf:"varint,16,opt,name=version,proto3" json:"version,omitempty"`
+	Description    string                 `protobuf:"bytes,17,opt,name=description,proto3" json:"description,omitempty"`
+	Tags           []string               `protobuf:"bytes,18,rep,name=tags,proto3" json:"tags,omitempty"`
+	Notes          string                 `protobuf:"bytes,19,opt,name=notes,proto3" json:"notes,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -177,6 +182,34 @@ func (x *Customer) GetDeletedAt() string {
 	return ""
 }
 
+func (x *Customer) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Customer) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Customer) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Customer) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
 type CreateCustomerRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -190,6 +223,9 @@ type CreateCustomerRequest struct {
 	Email          string                 `protobuf:"bytes,9,opt,name=email,proto3" json:"email,omitempty"`
 	AdditionalInfo map[string]string      `protobuf:"bytes,10,rep,name=additional_info,json=additionalInfo,proto3" json:"additional_info,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	UserId         int64                  `protobuf:"varint,11,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Description    string                 `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
+	Tags           []string               `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`
+	Notes          string                 `protobuf:"bytes,14,opt,name=notes,proto3" json:"notes,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -301,6 +337,27 @@ func (x *CreateCustomerRequest) GetUserId() int64 {
 	return 0
 }
 
+func (x *CreateCustomerRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCustomerRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateCustomerRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
 type CreateCustomerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
@@ -446,6 +503,11 @@ type UpdateCustomerRequest struct {
 	Phone          string                 `protobuf:"bytes,9,opt,name=phone,proto3" json:"phone,omitempty"`
 	Email          string                 `protobuf:"bytes,10,opt,name=email,proto3" json:"email,omitempty"`
 	AdditionalInfo map[string]string      `protobuf:"bytes,11,rep,name=additional_info,json=additionalInfo,proto3" json:"additional_info,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	UpdateMask     *fieldmaskpb.FieldMask `protobuf:"bytes,12,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Version        int64                  `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
+	Description    string                 `protobuf:"bytes,14,opt,name=description,proto3" json:"description,omitempty"`
+	Tags           []string               `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty"`
+	Notes          string                 `protobuf:"bytes,16,opt,name=notes,proto3" json:"notes,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -557,6 +619,41 @@ func (x *UpdateCustomerRequest) GetAdditionalInfo() map[string]string {
 	return nil
 }
 
+func (x *UpdateCustomerRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateCustomerRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateCustomerRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateCustomerRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateCustomerRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
 type UpdateCustomerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
@@ -604,6 +701,7 @@ func (x *UpdateCustomerResponse) GetCustomer() *Customer {
 type DeleteCustomerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -645,6 +743,13 @@ func (x *DeleteCustomerRequest) GetId() int64 {
 	return 0
 }
 
+func (x *DeleteCustomerRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type DeleteCustomerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -697,6 +802,9 @@ type ListCustomersRequest struct {
 	Email          string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
 	Phone          string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
 	AdditionalInfo string                 `protobuf:"bytes,6,opt,name=additional_info,json=additionalInfo,proto3" json:"additional_info,omitempty"`
+	PageToken      string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter         string                 `protobuf:"bytes,8,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy        string                 `protobuf:"bytes,9,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -773,12 +881,34 @@ func (x *ListCustomersRequest) GetAdditionalInfo() string {
 	return ""
 }
 
+func (x *ListCustomersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCustomersRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListCustomersRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListCustomersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Customers     []*Customer            `protobuf:"bytes,1,rep,name=customers,proto3" json:"customers,omitempty"`
 	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
 	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -841,11 +971,18 @@ func (x *ListCustomersResponse) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListCustomersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 var File_customer_proto protoreflect.FileDescriptor
 
 const file_customer_proto_rawDesc = "" +
 	"\n" +
-	"\x0ecustomer.proto\x12\x05admin\"\x8a\x04\n" +
+	"\x0ecustomer.proto\x12\x05admin\x1a google/protobuf/field_mask.proto\"\xf0\x04\n" +
 	"\bCustomer\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
@@ -867,10 +1004,14 @@ const file_customer_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x0e \x01(\tR\tupdatedAt\x12\x1d\n" +
 	"\n" +
-	"deleted_at\x18\x0f \x01(\tR\tdeletedAt\x1aA\n" +
+	"deleted_at\x18\x0f \x01(\tR\tdeletedAt\x12\x18\n" +
+	"\aversion\x18\x10 \x01(\x03R\aversion\x12 \n" +
+	"\vdescription\x18\x11 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04tags\x18\x12 \x03(\tR\x04tags\x12\x14\n" +
+	"\x05notes\x18\x13 \x01(\tR\x05notes\x1aA\n" +
 	"\x13AdditionalInfoEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb7\x03\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x83\x04\n" +
 	"\x15CreateCustomerRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
 	"\n" +
@@ -885,7 +1026,10 @@ const file_customer_proto_rawDesc = "" +
 	"\x05email\x18\t \x01(\tR\x05email\x12Y\n" +
 	"\x0fadditional_info\x18\n" +
 	" \x03(\v20.admin.CreateCustomerRequest.AdditionalInfoEntryR\x0eadditionalInfo\x12\x17\n" +
-	"\auser_id\x18\v \x01(\x03R\x06userId\x1aA\n" +
+	"\auser_id\x18\v \x01(\x03R\x06userId\x12 \n" +
+	"\vdescription\x18\f \x01(\tR\vdescription\x12\x12\n" +
+	"\x04tags\x18\r \x03(\tR\x04tags\x12\x14\n" +
+	"\x05notes\x18\x0e \x01(\tR\x05notes\x1aA\n" +
 	"\x13AdditionalInfoEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"E\n" +
@@ -894,7 +1038,7 @@ const file_customer_proto_rawDesc = "" +
 	"\x12GetCustomerRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"B\n" +
 	"\x13GetCustomerResponse\x12+\n" +
-	"\bcustomer\x18\x01 \x01(\v2\x0f.admin.CustomerR\bcustomer\"\xae\x03\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x0f.admin.CustomerR\bcustomer\"\xd1\x04\n" +
 	"\x15UpdateCustomerRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
@@ -909,28 +1053,40 @@ const file_customer_proto_rawDesc = "" +
 	"\x05phone\x18\t \x01(\tR\x05phone\x12\x14\n" +
 	"\x05email\x18\n" +
 	" \x01(\tR\x05email\x12Y\n" +
-	"\x0fadditional_info\x18\v \x03(\v20.admin.UpdateCustomerRequest.AdditionalInfoEntryR\x0eadditionalInfo\x1aA\n" +
+	"\x0fadditional_info\x18\v \x03(\v20.admin.UpdateCustomerRequest.AdditionalInfoEntryR\x0eadditionalInfo\x12;\n" +
+	"\vupdate_mask\x18\f \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x18\n" +
+	"\aversion\x18\r \x01(\x03R\aversion\x12 \n" +
+	"\vdescription\x18\x0e \x01(\tR\vdescription\x12\x12\n" +
+	"\x04tags\x18\x0f \x03(\tR\x04tags\x12\x14\n" +
+	"\x05notes\x18\x10 \x01(\tR\x05notes\x1aA\n" +
 	"\x13AdditionalInfoEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"E\n" +
 	"\x16UpdateCustomerResponse\x12+\n" +
-	"\bcustomer\x18\x01 \x01(\v2\x0f.admin.CustomerR\bcustomer\"'\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x0f.admin.CustomerR\bcustomer\"A\n" +
 	"\x15DeleteCustomerRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"2\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"2\n" +
 	"\x16DeleteCustomerResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa9\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xfb\x01\n" +
 	"\x14ListCustomersRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
 	"\x05email\x18\x04 \x01(\tR\x05email\x12\x14\n" +
 	"\x05phone\x18\x05 \x01(\tR\x05phone\x12'\n" +
-	"\x0fadditional_info\x18\x06 \x01(\tR\x0eadditionalInfo\"\x86\x01\n" +
+	"\x0fadditional_info\x18\x06 \x01(\tR\x0eadditionalInfo\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\b \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\t \x01(\tR\aorderBy\"\xae\x01\n" +
 	"\x15ListCustomersResponse\x12-\n" +
 	"\tcustomers\x18\x01 \x03(\v2\x0f.admin.CustomerR\tcustomers\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limitB\x1eZ\x1cpersacc/api/v1/admin;adminpbb\x06proto3"
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageTokenB\x1eZ\x1cpersacc/api/v1/admin;adminpbb\x06proto3"
 
 var (
 	file_customer_proto_rawDescOnce sync.Once
@@ -960,6 +1116,7 @@ var file_customer_proto_goTypes = []any{
 	nil,                            // 11: admin.Customer.AdditionalInfoEntry
 	nil,                            // 12: admin.CreateCustomerRequest.AdditionalInfoEntry
 	nil,                            // 13: admin.UpdateCustomerRequest.AdditionalInfoEntry
+	(*fieldmaskpb.FieldMask)(nil),  // 14: google.protobuf.FieldMask
 }
 var file_customer_proto_depIdxs = []int32{
 	11, // 0: admin.Customer.additional_info:type_name -> admin.Customer.AdditionalInfoEntry
@@ -967,13 +1124,14 @@ var file_customer_proto_depIdxs = []int32{
 	0,  // 2: admin.CreateCustomerResponse.customer:type_name -> admin.Customer
 	0,  // 3: admin.GetCustomerResponse.customer:type_name -> admin.Customer
 	13, // 4: admin.UpdateCustomerRequest.additional_info:type_name -> admin.UpdateCustomerRequest.AdditionalInfoEntry
-	0,  // 5: admin.UpdateCustomerResponse.customer:type_name -> admin.Customer
-	0,  // 6: admin.ListCustomersResponse.customers:type_name -> admin.Customer
-	7,  // [7:7] is the sub-list for method output_type
-	7,  // [7:7] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	14, // 5: admin.UpdateCustomerRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 6: admin.UpdateCustomerResponse.customer:type_name -> admin.Customer
+	0,  // 7: admin.ListCustomersResponse.customers:type_name -> admin.Customer
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_customer_proto_init() }
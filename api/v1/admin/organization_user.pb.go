@@ -0,0 +1,702 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.21.12
+// source: organization_user.proto
+
+package adminpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OrganizationUser struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId int64                  `protobuf:"varint,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UserId         int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email          string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Name           string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Role           string                 `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrganizationUser) Reset() {
+	*x = OrganizationUser{}
+	mi := &file_organization_user_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationUser) ProtoMessage() {}
+
+func (x *OrganizationUser) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_user_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationUser.ProtoReflect.Descriptor instead.
+func (*OrganizationUser) Descriptor() ([]byte, []int) {
+	return file_organization_user_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OrganizationUser) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrganizationUser) GetOrganizationId() int64 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *OrganizationUser) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *OrganizationUser) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *OrganizationUser) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrganizationUser) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *OrganizationUser) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *OrganizationUser) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type AddOrganizationUserRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId int64                  `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UserId         int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email          string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role           string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddOrganizationUserRequest) Reset() {
+	*x = AddOrganizationUserRequest{}
+	mi := &file_organization_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOrganizationUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrganizationUserRequest) ProtoMessage() {}
+
+func (x *AddOrganizationUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOrganizationUserRequest.ProtoReflect.Descriptor instead.
+func (*AddOrganizationUserRequest) Descriptor() ([]byte, []int) {
+	return file_organization_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddOrganizationUserRequest) GetOrganizationId() int64 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *AddOrganizationUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddOrganizationUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AddOrganizationUserRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type AddOrganizationUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *OrganizationUser      `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddOrganizationUserResponse) Reset() {
+	*x = AddOrganizationUserResponse{}
+	mi := &file_organization_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOrganizationUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrganizationUserResponse) ProtoMessage() {}
+
+func (x *AddOrganizationUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOrganizationUserResponse.ProtoReflect.Descriptor instead.
+func (*AddOrganizationUserResponse) Descriptor() ([]byte, []int) {
+	return file_organization_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddOrganizationUserResponse) GetMember() *OrganizationUser {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type ListOrganizationUsersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId int64                  `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Page           int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit          int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken      string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter         string                 `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy        string                 `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListOrganizationUsersRequest) Reset() {
+	*x = ListOrganizationUsersRequest{}
+	mi := &file_organization_user_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationUsersRequest) ProtoMessage() {}
+
+func (x *ListOrganizationUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_user_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrganizationUsersRequest) Descriptor() ([]byte, []int) {
+	return file_organization_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListOrganizationUsersRequest) GetOrganizationId() int64 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *ListOrganizationUsersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListOrganizationUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListOrganizationUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListOrganizationUsersRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListOrganizationUsersRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListOrganizationUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*OrganizationUser    `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationUsersResponse) Reset() {
+	*x = ListOrganizationUsersResponse{}
+	mi := &file_organization_user_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationUsersResponse) ProtoMessage() {}
+
+func (x *ListOrganizationUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_user_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrganizationUsersResponse) Descriptor() ([]byte, []int) {
+	return file_organization_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListOrganizationUsersResponse) GetMembers() []*OrganizationUser {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ListOrganizationUsersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListOrganizationUsersResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListOrganizationUsersResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListOrganizationUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateOrganizationUserRoleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId int64                  `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UserId         int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role           string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizationUserRoleRequest) Reset() {
+	*x = UpdateOrganizationUserRoleRequest{}
+	mi := &file_organization_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizationUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationUserRoleRequest) ProtoMessage() {}
+
+func (x *UpdateOrganizationUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizationUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_organization_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateOrganizationUserRoleRequest) GetOrganizationId() int64 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *UpdateOrganizationUserRoleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateOrganizationUserRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type UpdateOrganizationUserRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *OrganizationUser      `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizationUserRoleResponse) Reset() {
+	*x = UpdateOrganizationUserRoleResponse{}
+	mi := &file_organization_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizationUserRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationUserRoleResponse) ProtoMessage() {}
+
+func (x *UpdateOrganizationUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizationUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_organization_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateOrganizationUserRoleResponse) GetMember() *OrganizationUser {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type RemoveOrganizationUserRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId int64                  `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UserId         int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RemoveOrganizationUserRequest) Reset() {
+	*x = RemoveOrganizationUserRequest{}
+	mi := &file_organization_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveOrganizationUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrganizationUserRequest) ProtoMessage() {}
+
+func (x *RemoveOrganizationUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOrganizationUserRequest.ProtoReflect.Descriptor instead.
+func (*RemoveOrganizationUserRequest) Descriptor() ([]byte, []int) {
+	return file_organization_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RemoveOrganizationUserRequest) GetOrganizationId() int64 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *RemoveOrganizationUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RemoveOrganizationUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveOrganizationUserResponse) Reset() {
+	*x = RemoveOrganizationUserResponse{}
+	mi := &file_organization_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveOrganizationUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrganizationUserResponse) ProtoMessage() {}
+
+func (x *RemoveOrganizationUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOrganizationUserResponse.ProtoReflect.Descriptor instead.
+func (*RemoveOrganizationUserResponse) Descriptor() ([]byte, []int) {
+	return file_organization_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RemoveOrganizationUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+var File_organization_user_proto protoreflect.FileDescriptor
+
+const file_organization_user_proto_rawDesc = "" +
+	"\n" +
+	"\x17organization_user.proto\x12\x05admin\"\xe0\x01\n" +
+	"\x10OrganizationUser\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\x03R\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x12\n" +
+	"\x04role\x18\x06 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"\x88\x01\n" +
+	"\x1aAddOrganizationUserRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\x03R\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"N\n" +
+	"\x1bAddOrganizationUserResponse\x12/\n" +
+	"\x06member\x18\x01 \x01(\v2\x17.admin.OrganizationUserR\x06member\"\xc3\x01\n" +
+	"\x1cListOrganizationUsersRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\x03R\x0eorganizationId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x05 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x06 \x01(\tR\aorderBy\"\xba\x01\n" +
+	"\x1dListOrganizationUsersResponse\x121\n" +
+	"\amembers\x18\x01 \x03(\v2\x17.admin.OrganizationUserR\amembers\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\"y\n" +
+	"!UpdateOrganizationUserRoleRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\x03R\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"U\n" +
+	"\"UpdateOrganizationUserRoleResponse\x12/\n" +
+	"\x06member\x18\x01 \x01(\v2\x17.admin.OrganizationUserR\x06member\"a\n" +
+	"\x1dRemoveOrganizationUserRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\x03R\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\":\n" +
+	"\x1eRemoveOrganizationUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccessB\x1eZ\x1cpersacc/api/v1/admin;adminpbb\x06proto3"
+
+var (
+	file_organization_user_proto_rawDescOnce sync.Once
+	file_organization_user_proto_rawDescData []byte
+)
+
+func file_organization_user_proto_rawDescGZIP() []byte {
+	file_organization_user_proto_rawDescOnce.Do(func() {
+		file_organization_user_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_organization_user_proto_rawDesc), len(file_organization_user_proto_rawDesc)))
+	})
+	return file_organization_user_proto_rawDescData
+}
+
+var file_organization_user_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_organization_user_proto_goTypes = []any{
+	(*OrganizationUser)(nil),                   // 0: admin.OrganizationUser
+	(*AddOrganizationUserRequest)(nil),         // 1: admin.AddOrganizationUserRequest
+	(*AddOrganizationUserResponse)(nil),        // 2: admin.AddOrganizationUserResponse
+	(*ListOrganizationUsersRequest)(nil),       // 3: admin.ListOrganizationUsersRequest
+	(*ListOrganizationUsersResponse)(nil),      // 4: admin.ListOrganizationUsersResponse
+	(*UpdateOrganizationUserRoleRequest)(nil),  // 5: admin.UpdateOrganizationUserRoleRequest
+	(*UpdateOrganizationUserRoleResponse)(nil), // 6: admin.UpdateOrganizationUserRoleResponse
+	(*RemoveOrganizationUserRequest)(nil),      // 7: admin.RemoveOrganizationUserRequest
+	(*RemoveOrganizationUserResponse)(nil),     // 8: admin.RemoveOrganizationUserResponse
+}
+var file_organization_user_proto_depIdxs = []int32{
+	0, // 0: admin.AddOrganizationUserResponse.member:type_name -> admin.OrganizationUser
+	0, // 1: admin.ListOrganizationUsersResponse.members:type_name -> admin.OrganizationUser
+	0, // 2: admin.UpdateOrganizationUserRoleResponse.member:type_name -> admin.OrganizationUser
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_organization_user_proto_init() }
+func file_organization_user_proto_init() {
+	if File_organization_user_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_organization_user_proto_rawDesc), len(file_organization_user_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_organization_user_proto_goTypes,
+		DependencyIndexes: file_organization_user_proto_depIdxs,
+		MessageInfos:      file_organization_user_proto_msgTypes,
+	}.Build()
+	File_organization_user_proto = out.File
+	file_organization_user_proto_goTypes = nil
+	file_organization_user_proto_depIdxs = nil
+}
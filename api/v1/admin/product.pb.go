@@ -9,6 +9,7 @@ package adminpb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -35,6 +36,7 @@ type Product struct {
 	CategoryId        int64                  `protobuf:"varint,10,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	VendorId          int64                  `protobuf:"varint,11,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
 	VendorProductCode string                 `protobuf:"bytes,12,opt,name=vendor_product_code,json=vendorProductCode,proto3" json:"vendor_product_code,omitempty"`
+	Version           int64                  `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -153,6 +155,13 @@ func (x *Product) GetVendorProductCode() string {
 	return ""
 }
 
+func (x *Product) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type CreateProductRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Sku               string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
@@ -162,6 +171,7 @@ type CreateProductRequest struct {
 	CategoryId        int64                  `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	VendorId          int64                  `protobuf:"varint,6,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
 	VendorProductCode string                 `protobuf:"bytes,7,opt,name=vendor_product_code,json=vendorProductCode,proto3" json:"vendor_product_code,omitempty"`
+	GenerateSku       bool                   `protobuf:"varint,8,opt,name=generate_sku,json=generateSku,proto3" json:"generate_sku,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -245,6 +255,13 @@ func (x *CreateProductRequest) GetVendorProductCode() string {
 	return ""
 }
 
+func (x *CreateProductRequest) GetGenerateSku() bool {
+	if x != nil {
+		return x.GenerateSku
+	}
+	return false
+}
+
 type CreateProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
@@ -387,6 +404,8 @@ type UpdateProductRequest struct {
 	CategoryId        int64                  `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	VendorId          int64                  `protobuf:"varint,7,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
 	VendorProductCode string                 `protobuf:"bytes,8,opt,name=vendor_product_code,json=vendorProductCode,proto3" json:"vendor_product_code,omitempty"`
+	UpdateMask        *fieldmaskpb.FieldMask `protobuf:"bytes,9,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Version           int64                  `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -477,6 +496,20 @@ func (x *UpdateProductRequest) GetVendorProductCode() string {
 	return ""
 }
 
+func (x *UpdateProductRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type UpdateProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
@@ -524,6 +557,7 @@ func (x *UpdateProductResponse) GetProduct() *Product {
 type DeleteProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -565,6 +599,13 @@ func (x *DeleteProductRequest) GetId() int64 {
 	return 0
 }
 
+func (x *DeleteProductRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type DeleteProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -616,6 +657,9 @@ type ListProductsRequest struct {
 	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	Sku           string                 `protobuf:"bytes,4,opt,name=sku,proto3" json:"sku,omitempty"`
 	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter        string                 `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,8,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -685,12 +729,34 @@ func (x *ListProductsRequest) GetDescription() string {
 	return ""
 }
 
+func (x *ListProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListProductsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
 	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
 	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -753,11 +819,18 @@ func (x *ListProductsResponse) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListProductsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 var File_product_proto protoreflect.FileDescriptor
 
 const file_product_proto_rawDesc = "" +
 	"\n" +
-	"\rproduct.proto\x12\x05admin\"\xf1\x03\n" +
+	"\rproduct.proto\x12\x05admin\x1a google/protobuf/field_mask.proto\"\x8b\x04\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\x03R\x0eorganizationId\x12\x10\n" +
@@ -775,10 +848,11 @@ const file_product_proto_rawDesc = "" +
 	" \x01(\x03R\n" +
 	"categoryId\x12\x1b\n" +
 	"\tvendor_id\x18\v \x01(\x03R\bvendorId\x12.\n" +
-	"\x13vendor_product_code\x18\f \x01(\tR\x11vendorProductCode\x1aD\n" +
+	"\x13vendor_product_code\x18\f \x01(\tR\x11vendorProductCode\x12\x18\n" +
+	"\aversion\x18\r \x01(\x03R\aversion\x1aD\n" +
 	"\x16AdditionalDetailsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf5\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x98\x03\n" +
 	"\x14CreateProductRequest\x12\x10\n" +
 	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -787,7 +861,8 @@ const file_product_proto_rawDesc = "" +
 	"\vcategory_id\x18\x05 \x01(\x03R\n" +
 	"categoryId\x12\x1b\n" +
 	"\tvendor_id\x18\x06 \x01(\x03R\bvendorId\x12.\n" +
-	"\x13vendor_product_code\x18\a \x01(\tR\x11vendorProductCode\x1aD\n" +
+	"\x13vendor_product_code\x18\a \x01(\tR\x11vendorProductCode\x12!\n" +
+	"\fgenerate_sku\x18\b \x01(\bR\vgenerateSku\x1aD\n" +
 	"\x16AdditionalDetailsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"A\n" +
@@ -796,7 +871,7 @@ const file_product_proto_rawDesc = "" +
 	"\x11GetProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\">\n" +
 	"\x12GetProductResponse\x12(\n" +
-	"\aproduct\x18\x01 \x01(\v2\x0e.admin.ProductR\aproduct\"\x85\x03\n" +
+	"\aproduct\x18\x01 \x01(\v2\x0e.admin.ProductR\aproduct\"\xdc\x03\n" +
 	"\x14UpdateProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
 	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x12\n" +
@@ -806,27 +881,37 @@ const file_product_proto_rawDesc = "" +
 	"\vcategory_id\x18\x06 \x01(\x03R\n" +
 	"categoryId\x12\x1b\n" +
 	"\tvendor_id\x18\a \x01(\x03R\bvendorId\x12.\n" +
-	"\x13vendor_product_code\x18\b \x01(\tR\x11vendorProductCode\x1aD\n" +
+	"\x13vendor_product_code\x18\b \x01(\tR\x11vendorProductCode\x12;\n" +
+	"\vupdate_mask\x18\t \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x03R\aversion\x1aD\n" +
 	"\x16AdditionalDetailsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"A\n" +
 	"\x15UpdateProductResponse\x12(\n" +
-	"\aproduct\x18\x01 \x01(\v2\x0e.admin.ProductR\aproduct\"&\n" +
+	"\aproduct\x18\x01 \x01(\v2\x0e.admin.ProductR\aproduct\"@\n" +
 	"\x14DeleteProductRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"1\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"1\n" +
 	"\x15DeleteProductResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x87\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd9\x01\n" +
 	"\x13ListProductsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x10\n" +
 	"\x03sku\x18\x04 \x01(\tR\x03sku\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\"\x82\x01\n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\a \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\b \x01(\tR\aorderBy\"\xaa\x01\n" +
 	"\x14ListProductsResponse\x12*\n" +
 	"\bproducts\x18\x01 \x03(\v2\x0e.admin.ProductR\bproducts\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limitB\x1eZ\x1cpersacc/api/v1/admin;adminpbb\x06proto3"
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageTokenB\x1eZ\x1cpersacc/api/v1/admin;adminpbb\x06proto3"
 
 var (
 	file_product_proto_rawDescOnce sync.Once
@@ -856,6 +941,7 @@ var file_product_proto_goTypes = []any{
 	nil,                           // 11: admin.Product.AdditionalDetailsEntry
 	nil,                           // 12: admin.CreateProductRequest.AdditionalDetailsEntry
 	nil,                           // 13: admin.UpdateProductRequest.AdditionalDetailsEntry
+	(*fieldmaskpb.FieldMask)(nil), // 14: google.protobuf.FieldMask
 }
 var file_product_proto_depIdxs = []int32{
 	11, // 0: admin.Product.additional_details:type_name -> admin.Product.AdditionalDetailsEntry
@@ -863,13 +949,14 @@ var file_product_proto_depIdxs = []int32{
 	0,  // 2: admin.CreateProductResponse.product:type_name -> admin.Product
 	0,  // 3: admin.GetProductResponse.product:type_name -> admin.Product
 	13, // 4: admin.UpdateProductRequest.additional_details:type_name -> admin.UpdateProductRequest.AdditionalDetailsEntry
-	0,  // 5: admin.UpdateProductResponse.product:type_name -> admin.Product
-	0,  // 6: admin.ListProductsResponse.products:type_name -> admin.Product
-	7,  // [7:7] is the sub-list for method output_type
-	7,  // [7:7] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	14, // 5: admin.UpdateProductRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 6: admin.UpdateProductResponse.product:type_name -> admin.Product
+	0,  // 7: admin.ListProductsResponse.products:type_name -> admin.Product
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_product_proto_init() }
@@ -9,6 +9,7 @@ package adminpb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -29,6 +30,7 @@ type ProductCategory struct {
 	Description    string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	CreatedAt      string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt      string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version        int64                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -105,6 +107,13 @@ func (x *ProductCategory) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *ProductCategory) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type CreateProductCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -294,6 +303,8 @@ type UpdateProductCategoryRequest struct {
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Version       int64                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -349,6 +360,20 @@ func (x *UpdateProductCategoryRequest) GetDescription() string {
 	return ""
 }
 
+func (x *UpdateProductCategoryRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateProductCategoryRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type UpdateProductCategoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Category      *ProductCategory       `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
@@ -396,6 +421,7 @@ func (x *UpdateProductCategoryResponse) GetCategory() *ProductCategory {
 type DeleteProductCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -437,6 +463,13 @@ func (x *DeleteProductCategoryRequest) GetId() int64 {
 	return 0
 }
 
+func (x *DeleteProductCategoryRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type DeleteProductCategoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -486,6 +519,9 @@ type ListProductCategoriesRequest struct {
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter        string                 `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -541,12 +577,34 @@ func (x *ListProductCategoriesRequest) GetName() string {
 	return ""
 }
 
+func (x *ListProductCategoriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListProductCategoriesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListProductCategoriesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListProductCategoriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Categories    []*ProductCategory     `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
 	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
 	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -609,11 +667,18 @@ func (x *ListProductCategoriesResponse) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListProductCategoriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 var File_product_category_proto protoreflect.FileDescriptor
 
 const file_product_category_proto_rawDesc = "" +
 	"\n" +
-	"\x16product_category.proto\x12\x05admin\"\xbe\x01\n" +
+	"\x16product_category.proto\x12\x05admin\x1a google/protobuf/field_mask.proto\"\xd8\x01\n" +
 	"\x0fProductCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\x03R\x0eorganizationId\x12\x12\n" +
@@ -622,7 +687,8 @@ const file_product_category_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"T\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\a \x01(\x03R\aversion\"T\n" +
 	"\x1cCreateProductCategoryRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\"S\n" +
@@ -631,28 +697,37 @@ const file_product_category_proto_rawDesc = "" +
 	"\x19GetProductCategoryRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"P\n" +
 	"\x1aGetProductCategoryResponse\x122\n" +
-	"\bcategory\x18\x01 \x01(\v2\x16.admin.ProductCategoryR\bcategory\"d\n" +
+	"\bcategory\x18\x01 \x01(\v2\x16.admin.ProductCategoryR\bcategory\"\xbb\x01\n" +
 	"\x1cUpdateProductCategoryRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"S\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12;\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x03R\aversion\"S\n" +
 	"\x1dUpdateProductCategoryResponse\x122\n" +
-	"\bcategory\x18\x01 \x01(\v2\x16.admin.ProductCategoryR\bcategory\".\n" +
+	"\bcategory\x18\x01 \x01(\v2\x16.admin.ProductCategoryR\bcategory\"H\n" +
 	"\x1cDeleteProductCategoryRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"9\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"9\n" +
 	"\x1dDeleteProductCategoryResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\\\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xae\x01\n" +
 	"\x1cListProductCategoriesRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\"\x97\x01\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x05 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x06 \x01(\tR\aorderBy\"\xbf\x01\n" +
 	"\x1dListProductCategoriesResponse\x126\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x16.admin.ProductCategoryR\n" +
 	"categories\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limitB\x1eZ\x1cpersacc/api/v1/admin;adminpbb\x06proto3"
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageTokenB\x1eZ\x1cpersacc/api/v1/admin;adminpbb\x06proto3"
 
 var (
 	file_product_category_proto_rawDescOnce sync.Once
@@ -679,17 +754,19 @@ var file_product_category_proto_goTypes = []any{
 	(*DeleteProductCategoryResponse)(nil), // 8: admin.DeleteProductCategoryResponse
 	(*ListProductCategoriesRequest)(nil),  // 9: admin.ListProductCategoriesRequest
 	(*ListProductCategoriesResponse)(nil), // 10: admin.ListProductCategoriesResponse
+	(*fieldmaskpb.FieldMask)(nil),         // 11: google.protobuf.FieldMask
 }
 var file_product_category_proto_depIdxs = []int32{
-	0, // 0: admin.CreateProductCategoryResponse.category:type_name -> admin.ProductCategory
-	0, // 1: admin.GetProductCategoryResponse.category:type_name -> admin.ProductCategory
-	0, // 2: admin.UpdateProductCategoryResponse.category:type_name -> admin.ProductCategory
-	0, // 3: admin.ListProductCategoriesResponse.categories:type_name -> admin.ProductCategory
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: admin.CreateProductCategoryResponse.category:type_name -> admin.ProductCategory
+	0,  // 1: admin.GetProductCategoryResponse.category:type_name -> admin.ProductCategory
+	11, // 2: admin.UpdateProductCategoryRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 3: admin.UpdateProductCategoryResponse.category:type_name -> admin.ProductCategory
+	0,  // 4: admin.ListProductCategoriesResponse.categories:type_name -> admin.ProductCategory
+	5,  // [5:5] is the sub-list for method output_type
+	5,  // [5:5] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_product_category_proto_init() }
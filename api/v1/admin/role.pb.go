@@ -9,6 +9,7 @@ package adminpb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -26,6 +27,7 @@ type Role struct {
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Permissions   []*Permission          `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Version       int64                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,6 +83,13 @@ func (x *Role) GetPermissions() []*Permission {
 	return nil
 }
 
+func (x *Role) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type CreateRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -270,6 +279,8 @@ type UpdateRoleRequest struct {
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	PermissionIds []int64                `protobuf:"varint,3,rep,packed,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Version       int64                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -325,6 +336,20 @@ func (x *UpdateRoleRequest) GetPermissionIds() []int64 {
 	return nil
 }
 
+func (x *UpdateRoleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateRoleRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type UpdateRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
@@ -372,6 +397,7 @@ func (x *UpdateRoleResponse) GetRole() *Role {
 type DeleteRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -413,6 +439,13 @@ func (x *DeleteRoleRequest) GetId() int64 {
 	return 0
 }
 
+func (x *DeleteRoleRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type DeleteRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -461,6 +494,9 @@ type ListRolesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter        string                 `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -509,12 +545,34 @@ func (x *ListRolesRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListRolesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRolesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListRolesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListRolesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Roles         []*Role                `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
 	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
 	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -577,16 +635,24 @@ func (x *ListRolesResponse) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListRolesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 var File_role_proto protoreflect.FileDescriptor
 
 const file_role_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"role.proto\x12\x05admin\x1a\x10permission.proto\"_\n" +
+	"role.proto\x12\x05admin\x1a\x10permission.proto\x1a google/protobuf/field_mask.proto\"y\n" +
 	"\x04Role\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x123\n" +
-	"\vpermissions\x18\x03 \x03(\v2\x11.admin.PermissionR\vpermissions\"N\n" +
+	"\vpermissions\x18\x03 \x03(\v2\x11.admin.PermissionR\vpermissions\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x03R\aversion\"N\n" +
 	"\x11CreateRoleRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
 	"\x0epermission_ids\x18\x02 \x03(\x03R\rpermissionIds\"5\n" +
@@ -595,25 +661,34 @@ const file_role_proto_rawDesc = "" +
 	"\x0eGetRoleRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"2\n" +
 	"\x0fGetRoleResponse\x12\x1f\n" +
-	"\x04role\x18\x01 \x01(\v2\v.admin.RoleR\x04role\"^\n" +
+	"\x04role\x18\x01 \x01(\v2\v.admin.RoleR\x04role\"\xb5\x01\n" +
 	"\x11UpdateRoleRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
-	"\x0epermission_ids\x18\x03 \x03(\x03R\rpermissionIds\"5\n" +
+	"\x0epermission_ids\x18\x03 \x03(\x03R\rpermissionIds\x12;\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x03R\aversion\"5\n" +
 	"\x12UpdateRoleResponse\x12\x1f\n" +
-	"\x04role\x18\x01 \x01(\v2\v.admin.RoleR\x04role\"#\n" +
+	"\x04role\x18\x01 \x01(\v2\v.admin.RoleR\x04role\"=\n" +
 	"\x11DeleteRoleRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\".\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\".\n" +
 	"\x12DeleteRoleResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"<\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x8e\x01\n" +
 	"\x10ListRolesRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"v\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderBy\"\x9e\x01\n" +
 	"\x11ListRolesResponse\x12!\n" +
 	"\x05roles\x18\x01 \x03(\v2\v.admin.RoleR\x05roles\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limitB\x1eZ\x1cpersacc/api/v1/admin;adminpbb\x06proto3"
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageTokenB\x1eZ\x1cpersacc/api/v1/admin;adminpbb\x06proto3"
 
 var (
 	file_role_proto_rawDescOnce sync.Once
@@ -629,30 +704,32 @@ func file_role_proto_rawDescGZIP() []byte {
 
 var file_role_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_role_proto_goTypes = []any{
-	(*Role)(nil),               // 0: admin.Role
-	(*CreateRoleRequest)(nil),  // 1: admin.CreateRoleRequest
-	(*CreateRoleResponse)(nil), // 2: admin.CreateRoleResponse
-	(*GetRoleRequest)(nil),     // 3: admin.GetRoleRequest
-	(*GetRoleResponse)(nil),    // 4: admin.GetRoleResponse
-	(*UpdateRoleRequest)(nil),  // 5: admin.UpdateRoleRequest
-	(*UpdateRoleResponse)(nil), // 6: admin.UpdateRoleResponse
-	(*DeleteRoleRequest)(nil),  // 7: admin.DeleteRoleRequest
-	(*DeleteRoleResponse)(nil), // 8: admin.DeleteRoleResponse
-	(*ListRolesRequest)(nil),   // 9: admin.ListRolesRequest
-	(*ListRolesResponse)(nil),  // 10: admin.ListRolesResponse
-	(*Permission)(nil),         // 11: admin.Permission
+	(*Role)(nil),                  // 0: admin.Role
+	(*CreateRoleRequest)(nil),     // 1: admin.CreateRoleRequest
+	(*CreateRoleResponse)(nil),    // 2: admin.CreateRoleResponse
+	(*GetRoleRequest)(nil),        // 3: admin.GetRoleRequest
+	(*GetRoleResponse)(nil),       // 4: admin.GetRoleResponse
+	(*UpdateRoleRequest)(nil),     // 5: admin.UpdateRoleRequest
+	(*UpdateRoleResponse)(nil),    // 6: admin.UpdateRoleResponse
+	(*DeleteRoleRequest)(nil),     // 7: admin.DeleteRoleRequest
+	(*DeleteRoleResponse)(nil),    // 8: admin.DeleteRoleResponse
+	(*ListRolesRequest)(nil),      // 9: admin.ListRolesRequest
+	(*ListRolesResponse)(nil),     // 10: admin.ListRolesResponse
+	(*Permission)(nil),            // 11: admin.Permission
+	(*fieldmaskpb.FieldMask)(nil), // 12: google.protobuf.FieldMask
 }
 var file_role_proto_depIdxs = []int32{
 	11, // 0: admin.Role.permissions:type_name -> admin.Permission
 	0,  // 1: admin.CreateRoleResponse.role:type_name -> admin.Role
 	0,  // 2: admin.GetRoleResponse.role:type_name -> admin.Role
-	0,  // 3: admin.UpdateRoleResponse.role:type_name -> admin.Role
-	0,  // 4: admin.ListRolesResponse.roles:type_name -> admin.Role
-	5,  // [5:5] is the sub-list for method output_type
-	5,  // [5:5] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	12, // 3: admin.UpdateRoleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 4: admin.UpdateRoleResponse.role:type_name -> admin.Role
+	0,  // 5: admin.ListRolesResponse.roles:type_name -> admin.Role
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_role_proto_init() }
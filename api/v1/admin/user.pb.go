@@ -9,6 +9,7 @@ package adminpb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -27,6 +28,7 @@ type User struct {
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
 	RoleId        int64                  `protobuf:"varint,4,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Version       int64                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -89,6 +91,13 @@ func (x *User) GetRoleId() int64 {
 	return 0
 }
 
+func (x *User) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type RegisterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -375,6 +384,8 @@ type UpdateUserRequest struct {
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
 	RoleId        int64                  `protobuf:"varint,4,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Version       int64                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -437,6 +448,20 @@ func (x *UpdateUserRequest) GetRoleId() int64 {
 	return 0
 }
 
+func (x *UpdateUserRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type UpdateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -484,6 +509,7 @@ func (x *UpdateUserResponse) GetUser() *User {
 type DeleteUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -525,6 +551,13 @@ func (x *DeleteUserRequest) GetId() int64 {
 	return 0
 }
 
+func (x *DeleteUserRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type DeleteUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -573,6 +606,9 @@ type ListUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter        string                 `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -621,12 +657,34 @@ func (x *ListUsersRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
 	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -689,17 +747,25 @@ func (x *ListUsersResponse) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 var File_user_proto protoreflect.FileDescriptor
 
 const file_user_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"user.proto\x12\x05admin\"Y\n" +
+	"user.proto\x12\x05admin\x1a google/protobuf/field_mask.proto\"s\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05email\x18\x03 \x01(\tR\x05email\x12\x17\n" +
-	"\arole_id\x18\x04 \x01(\x03R\x06roleId\"\x11\n" +
+	"\arole_id\x18\x04 \x01(\x03R\x06roleId\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x03R\aversion\"\x11\n" +
 	"\x0fRegisterRequest\"3\n" +
 	"\x10RegisterResponse\x12\x1f\n" +
 	"\x04user\x18\x01 \x01(\v2\v.admin.UserR\x04user\"r\n" +
@@ -713,26 +779,35 @@ const file_user_proto_rawDesc = "" +
 	"\x0eGetUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"2\n" +
 	"\x0fGetUserResponse\x12\x1f\n" +
-	"\x04user\x18\x01 \x01(\v2\v.admin.UserR\x04user\"f\n" +
+	"\x04user\x18\x01 \x01(\v2\v.admin.UserR\x04user\"\xbd\x01\n" +
 	"\x11UpdateUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05email\x18\x03 \x01(\tR\x05email\x12\x17\n" +
-	"\arole_id\x18\x04 \x01(\x03R\x06roleId\"5\n" +
+	"\arole_id\x18\x04 \x01(\x03R\x06roleId\x12;\n" +
+	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\"5\n" +
 	"\x12UpdateUserResponse\x12\x1f\n" +
-	"\x04user\x18\x01 \x01(\v2\v.admin.UserR\x04user\"#\n" +
+	"\x04user\x18\x01 \x01(\v2\v.admin.UserR\x04user\"=\n" +
 	"\x11DeleteUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\".\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\".\n" +
 	"\x12DeleteUserResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"<\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x8e\x01\n" +
 	"\x10ListUsersRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"v\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderBy\"\x9e\x01\n" +
 	"\x11ListUsersResponse\x12!\n" +
 	"\x05users\x18\x01 \x03(\v2\v.admin.UserR\x05users\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limitB\x1eZ\x1cpersacc/api/v1/admin;adminpbb\x06proto3"
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageTokenB\x1eZ\x1cpersacc/api/v1/admin;adminpbb\x06proto3"
 
 var (
 	file_user_proto_rawDescOnce sync.Once
@@ -748,31 +823,33 @@ func file_user_proto_rawDescGZIP() []byte {
 
 var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_user_proto_goTypes = []any{
-	(*User)(nil),               // 0: admin.User
-	(*RegisterRequest)(nil),    // 1: admin.RegisterRequest
-	(*RegisterResponse)(nil),   // 2: admin.RegisterResponse
-	(*CreateUserRequest)(nil),  // 3: admin.CreateUserRequest
-	(*CreateUserResponse)(nil), // 4: admin.CreateUserResponse
-	(*GetUserRequest)(nil),     // 5: admin.GetUserRequest
-	(*GetUserResponse)(nil),    // 6: admin.GetUserResponse
-	(*UpdateUserRequest)(nil),  // 7: admin.UpdateUserRequest
-	(*UpdateUserResponse)(nil), // 8: admin.UpdateUserResponse
-	(*DeleteUserRequest)(nil),  // 9: admin.DeleteUserRequest
-	(*DeleteUserResponse)(nil), // 10: admin.DeleteUserResponse
-	(*ListUsersRequest)(nil),   // 11: admin.ListUsersRequest
-	(*ListUsersResponse)(nil),  // 12: admin.ListUsersResponse
+	(*User)(nil),                  // 0: admin.User
+	(*RegisterRequest)(nil),       // 1: admin.RegisterRequest
+	(*RegisterResponse)(nil),      // 2: admin.RegisterResponse
+	(*CreateUserRequest)(nil),     // 3: admin.CreateUserRequest
+	(*CreateUserResponse)(nil),    // 4: admin.CreateUserResponse
+	(*GetUserRequest)(nil),        // 5: admin.GetUserRequest
+	(*GetUserResponse)(nil),       // 6: admin.GetUserResponse
+	(*UpdateUserRequest)(nil),     // 7: admin.UpdateUserRequest
+	(*UpdateUserResponse)(nil),    // 8: admin.UpdateUserResponse
+	(*DeleteUserRequest)(nil),     // 9: admin.DeleteUserRequest
+	(*DeleteUserResponse)(nil),    // 10: admin.DeleteUserResponse
+	(*ListUsersRequest)(nil),      // 11: admin.ListUsersRequest
+	(*ListUsersResponse)(nil),     // 12: admin.ListUsersResponse
+	(*fieldmaskpb.FieldMask)(nil), // 13: google.protobuf.FieldMask
 }
 var file_user_proto_depIdxs = []int32{
-	0, // 0: admin.RegisterResponse.user:type_name -> admin.User
-	0, // 1: admin.CreateUserResponse.user:type_name -> admin.User
-	0, // 2: admin.GetUserResponse.user:type_name -> admin.User
-	0, // 3: admin.UpdateUserResponse.user:type_name -> admin.User
-	0, // 4: admin.ListUsersResponse.users:type_name -> admin.User
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	0,  // 0: admin.RegisterResponse.user:type_name -> admin.User
+	0,  // 1: admin.CreateUserResponse.user:type_name -> admin.User
+	0,  // 2: admin.GetUserResponse.user:type_name -> admin.User
+	13, // 3: admin.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 4: admin.UpdateUserResponse.user:type_name -> admin.User
+	0,  // 5: admin.ListUsersResponse.users:type_name -> admin.User
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_user_proto_init() }
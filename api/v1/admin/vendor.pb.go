@@ -9,6 +9,7 @@ package adminpb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -29,6 +30,7 @@ type Vendor struct {
 	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version       int64                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -105,6 +107,13 @@ func (x *Vendor) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *Vendor) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type CreateVendorRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -303,6 +312,8 @@ type UpdateVendorRequest struct {
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
 	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Version       int64                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -365,6 +376,20 @@ func (x *UpdateVendorRequest) GetDescription() string {
 	return ""
 }
 
+func (x *UpdateVendorRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateVendorRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type UpdateVendorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Vendor        *Vendor                `protobuf:"bytes,1,opt,name=vendor,proto3" json:"vendor,omitempty"`
@@ -412,6 +437,7 @@ func (x *UpdateVendorResponse) GetVendor() *Vendor {
 type DeleteVendorRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -453,6 +479,13 @@ func (x *DeleteVendorRequest) GetId() int64 {
 	return 0
 }
 
+func (x *DeleteVendorRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type DeleteVendorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -502,6 +535,9 @@ type ListVendorsRequest struct {
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter        string                 `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -557,12 +593,34 @@ func (x *ListVendorsRequest) GetName() string {
 	return ""
 }
 
+func (x *ListVendorsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListVendorsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListVendorsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListVendorsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Vendors       []*Vendor              `protobuf:"bytes,1,rep,name=vendors,proto3" json:"vendors,omitempty"`
 	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
 	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -625,11 +683,18 @@ func (x *ListVendorsResponse) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListVendorsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 var File_vendor_proto protoreflect.FileDescriptor
 
 const file_vendor_proto_rawDesc = "" +
 	"\n" +
-	"\fvendor.proto\x12\x05admin\"\xa4\x01\n" +
+	"\fvendor.proto\x12\x05admin\x1a google/protobuf/field_mask.proto\"\xbe\x01\n" +
 	"\x06Vendor\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
@@ -638,7 +703,8 @@ const file_vendor_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"c\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\a \x01(\x03R\aversion\"c\n" +
 	"\x13CreateVendorRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
 	"\x06domain\x18\x02 \x01(\tR\x06domain\x12 \n" +
@@ -648,27 +714,36 @@ const file_vendor_proto_rawDesc = "" +
 	"\x10GetVendorRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\":\n" +
 	"\x11GetVendorResponse\x12%\n" +
-	"\x06vendor\x18\x01 \x01(\v2\r.admin.VendorR\x06vendor\"s\n" +
+	"\x06vendor\x18\x01 \x01(\v2\r.admin.VendorR\x06vendor\"\xca\x01\n" +
 	"\x13UpdateVendorRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
 	"\x06domain\x18\x03 \x01(\tR\x06domain\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\"=\n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12;\n" +
+	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\"=\n" +
 	"\x14UpdateVendorResponse\x12%\n" +
-	"\x06vendor\x18\x01 \x01(\v2\r.admin.VendorR\x06vendor\"%\n" +
+	"\x06vendor\x18\x01 \x01(\v2\r.admin.VendorR\x06vendor\"?\n" +
 	"\x13DeleteVendorRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"0\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"0\n" +
 	"\x14DeleteVendorResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"R\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa4\x01\n" +
 	"\x12ListVendorsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\"~\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x05 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x06 \x01(\tR\aorderBy\"\xa6\x01\n" +
 	"\x13ListVendorsResponse\x12'\n" +
 	"\avendors\x18\x01 \x03(\v2\r.admin.VendorR\avendors\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limitB\x1eZ\x1cpersacc/api/v1/admin;adminpbb\x06proto3"
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageTokenB\x1eZ\x1cpersacc/api/v1/admin;adminpbb\x06proto3"
 
 var (
 	file_vendor_proto_rawDescOnce sync.Once
@@ -684,28 +759,30 @@ func file_vendor_proto_rawDescGZIP() []byte {
 
 var file_vendor_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_vendor_proto_goTypes = []any{
-	(*Vendor)(nil),               // 0: admin.Vendor
-	(*CreateVendorRequest)(nil),  // 1: admin.CreateVendorRequest
-	(*CreateVendorResponse)(nil), // 2: admin.CreateVendorResponse
-	(*GetVendorRequest)(nil),     // 3: admin.GetVendorRequest
-	(*GetVendorResponse)(nil),    // 4: admin.GetVendorResponse
-	(*UpdateVendorRequest)(nil),  // 5: admin.UpdateVendorRequest
-	(*UpdateVendorResponse)(nil), // 6: admin.UpdateVendorResponse
-	(*DeleteVendorRequest)(nil),  // 7: admin.DeleteVendorRequest
-	(*DeleteVendorResponse)(nil), // 8: admin.DeleteVendorResponse
-	(*ListVendorsRequest)(nil),   // 9: admin.ListVendorsRequest
-	(*ListVendorsResponse)(nil),  // 10: admin.ListVendorsResponse
+	(*Vendor)(nil),                // 0: admin.Vendor
+	(*CreateVendorRequest)(nil),   // 1: admin.CreateVendorRequest
+	(*CreateVendorResponse)(nil),  // 2: admin.CreateVendorResponse
+	(*GetVendorRequest)(nil),      // 3: admin.GetVendorRequest
+	(*GetVendorResponse)(nil),     // 4: admin.GetVendorResponse
+	(*UpdateVendorRequest)(nil),   // 5: admin.UpdateVendorRequest
+	(*UpdateVendorResponse)(nil),  // 6: admin.UpdateVendorResponse
+	(*DeleteVendorRequest)(nil),   // 7: admin.DeleteVendorRequest
+	(*DeleteVendorResponse)(nil),  // 8: admin.DeleteVendorResponse
+	(*ListVendorsRequest)(nil),    // 9: admin.ListVendorsRequest
+	(*ListVendorsResponse)(nil),   // 10: admin.ListVendorsResponse
+	(*fieldmaskpb.FieldMask)(nil), // 11: google.protobuf.FieldMask
 }
 var file_vendor_proto_depIdxs = []int32{
-	0, // 0: admin.CreateVendorResponse.vendor:type_name -> admin.Vendor
-	0, // 1: admin.GetVendorResponse.vendor:type_name -> admin.Vendor
-	0, // 2: admin.UpdateVendorResponse.vendor:type_name -> admin.Vendor
-	0, // 3: admin.ListVendorsResponse.vendors:type_name -> admin.Vendor
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: admin.CreateVendorResponse.vendor:type_name -> admin.Vendor
+	0,  // 1: admin.GetVendorResponse.vendor:type_name -> admin.Vendor
+	11, // 2: admin.UpdateVendorRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 3: admin.UpdateVendorResponse.vendor:type_name -> admin.Vendor
+	0,  // 4: admin.ListVendorsResponse.vendors:type_name -> admin.Vendor
+	5,  // [5:5] is the sub-list for method output_type
+	5,  // [5:5] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_vendor_proto_init() }
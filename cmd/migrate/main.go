@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"persacc/internal/bootstrap"
+	"persacc/internal/config"
+	"persacc/internal/data"
+	"persacc/internal/migrate"
+)
+
+const usage = `Usage: migrate <command> [args]
+
+Commands:
+  up              apply all pending migrations
+  down [n]        roll back the last n applied migrations (default 1)
+  status          list migrations and whether they are applied
+  to <version>    migrate up or down to the given version (0 rolls back everything)
+  bootstrap [email]
+                  create the permission catalogue and default roles, and make
+                  email (or BOOTSTRAP_ADMIN_EMAIL) an admin
+
+The database is configured as for the server: from the YAML file named by
+CONFIG_FILE, if any, and the DB_* environment variables.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	cfg, err := config.Load(os.Getenv("CONFIG_FILE"))
+	if err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+
+	// Migrations may run longer than the server's statement timeout and
+	// must not read from a replica
+	cfg.Database.StatementTimeout = 0
+	cfg.Database.ReplicaDSN = ""
+	db, err := data.InitDB(context.Background(), cfg.Database)
+	if err != nil {
+		log.Fatalf("Failed to initialize database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Fatalf("Failed to get database handle: %v", err)
+	}
+	defer sqlDB.Close()
+
+	migrator, err := migrate.New(sqlDB)
+	if err != nil {
+		log.Fatalf("Failed to load migrations: %v", err)
+	}
+
+	ctx := context.Background()
+	args := os.Args[2:]
+
+	switch os.Args[1] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		report("Applied", applied)
+		if err != nil {
+			log.Fatalf("Migration failed: %v", err)
+		}
+	case "down":
+		steps := 1
+		if len(args) > 0 {
+			if steps, err = strconv.Atoi(args[0]); err != nil || steps < 1 {
+				log.Fatalf("Invalid number of steps %q", args[0])
+			}
+		}
+		rolledBack, err := migrator.Down(ctx, steps)
+		report("Rolled back", rolledBack)
+		if err != nil {
+			log.Fatalf("Rollback failed: %v", err)
+		}
+	case "to":
+		if len(args) != 1 {
+			log.Fatal("Usage: migrate to <version>")
+		}
+		version, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			log.Fatalf("Invalid version %q", args[0])
+		}
+		changed, err := migrator.To(ctx, version)
+		report("Migrated", changed)
+		if err != nil {
+			log.Fatalf("Migration failed: %v", err)
+		}
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			log.Fatalf("Failed to read migration status: %v", err)
+		}
+		for _, st := range statuses {
+			applied := "pending"
+			if st.Applied {
+				applied = "applied " + st.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%d  %-40s %s\n", st.Version, st.Name, applied)
+		}
+	case "bootstrap":
+		adminEmail := cfg.Bootstrap.AdminEmail
+		if len(args) > 0 {
+			adminEmail = args[0]
+		}
+		if err := bootstrap.Run(ctx, db, strings.TrimSpace(adminEmail)); err != nil {
+			log.Fatalf("Bootstrap failed: %v", err)
+		}
+		log.Println("Bootstrap complete.")
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+}
+
+func report(action string, migrations []migrate.Migration) {
+	if len(migrations) == 0 {
+		log.Println("No migrations to run.")
+		return
+	}
+	for _, m := range migrations {
+		log.Printf("%s %d_%s", action, m.Version, m.Name)
+	}
+}
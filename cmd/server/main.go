@@ -1,149 +1,369 @@
 package main
 
 import (
-	"log"
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
 	"net"
 	"net/http"
 	"os"
-	"strings"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
 
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
 	adminpb "persacc/api/v1/admin"
+	"persacc/internal/authn"
+	"persacc/internal/bootstrap"
+	"persacc/internal/config"
 	"persacc/internal/data"
+	"persacc/internal/logging"
+	"persacc/internal/metrics"
 	"persacc/internal/server"
+	"persacc/internal/service"
+	"persacc/internal/tlsutil"
+	"persacc/internal/tracing"
 
 	authpb "github.com/gevorgmb/oauth/api/v1/pb/proto"
 )
 
+const (
+	healthCheckInterval = 10 * time.Second
+	// certReloadInterval is how often certificate files are checked for
+	// renewal.
+	certReloadInterval = 30 * time.Second
+)
+
 func main() {
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8080"
+	configFile := flag.String("config", os.Getenv("CONFIG_FILE"),
+		"YAML configuration file; environment variables override its values")
+	bootstrapOnStart := flag.Bool("bootstrap", false,
+		"create permissions, default roles and the bootstrap admin before serving (also bootstrap.on_start)")
+	printConfig := flag.Bool("print-config", false, "print the effective configuration and exit")
+	flag.Parse()
+
+	cfg, err := config.Load(*configFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid configuration:\n%v\n", err)
+		os.Exit(1)
 	}
-	authAddr := os.Getenv("AUTH_SERVICE_ADDR")
-	authAddr = strings.TrimSpace(authAddr)
-	authAddr = strings.Trim(authAddr, "\"'")
-	if authAddr == "" {
-		authAddr = "localhost:50061"
-		log.Println("AUTH_SERVICE_ADDR not set, using default for client:", authAddr)
+	if *printConfig {
+		fmt.Print(cfg)
+		return
+	}
+
+	logger, err := logging.New(os.Stdout, cfg.Log.Level, cfg.Log.Format)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to configure logging: %v\n", err)
+		os.Exit(1)
+	}
+	slog.SetDefault(logger)
+	slog.Info("effective configuration", "config", cfg)
+	if cfg.UsesDefaultDSN() {
+		slog.Warn("database DSN not set, using the local development default")
+	}
+
+	// Stop on SIGINT or SIGTERM; a second signal kills the process
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// Export traces with the configured exporter (otlp, stdout or none)
+	shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing.Exporter)
+	if err != nil {
+		fatal("failed to configure tracing", err)
 	}
 
 	// 1. Initialize Database
-	db, err := data.InitDB()
+	db, err := data.InitDB(ctx, cfg.Database)
 	if err != nil {
-		log.Fatalf("Failed to initialize database: %v", err)
+		fatal("failed to initialize database", err)
+	}
+	slog.Info("database connection established")
+	if err := metrics.InstrumentDB(db); err != nil {
+		fatal("failed to instrument database", err)
+	}
+	if err := tracing.InstrumentDB(db); err != nil {
+		fatal("failed to instrument database", err)
+	}
+
+	// Optionally seed permissions, roles and the first admin
+	if *bootstrapOnStart || cfg.Bootstrap.OnStart {
+		if err := bootstrap.Run(ctx, db, cfg.Bootstrap.AdminEmail); err != nil {
+			fatal("failed to bootstrap database", err)
+		}
+		slog.Info("database bootstrap complete")
 	}
-	log.Println("Database connection established.")
 
 	// 2. Initialize Auth Service Client
-	// Use Insecure for now, in prod use TLS
-	log.Printf("Dialing auth service at: %s", authAddr)
-	authConn, err := grpc.NewClient(authAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	authCreds, err := authTransportCredentials(ctx, cfg.Auth.TLS)
 	if err != nil {
-		log.Fatalf("Failed to connect to auth service: %v", err)
+		fatal("failed to configure auth service TLS", err)
+	}
+	authConn, err := grpc.NewClient(cfg.Auth.Addr,
+		grpc.WithTransportCredentials(authCreds),
+		grpc.WithChainUnaryInterceptor(metrics.UnaryClientInterceptor("oauth")),
+		tracing.ClientOption(),
+	)
+	if err != nil {
+		fatal("failed to connect to auth service", err)
 	}
 	defer authConn.Close()
 	authClient := authpb.NewOAuthClient(authConn)
-	log.Printf("Successfully created gRPC client mapped to target %s\n", authAddr)
+	slog.Info("auth service client created", "auth_addr", cfg.Auth.Addr, "tls", cfg.Auth.TLS.Enabled)
 
 	// 3. Initialize Admin Server
-	srv := server.NewAdminServer(db, authClient)
+	// The organization access cache is shared so that organization changes
+	// made through the server are seen by the interceptor immediately.
+	// Verified tokens are cached for at most auth.token_cache_ttl; user, role
+	// and permission changes made through the server drop the affected
+	// entries.
+	orgAccess := service.NewOrganizationAccessService(db)
+	tokenCache := server.NewTokenCache(cfg.Auth.TokenCacheTTL, cfg.Auth.TokenCacheSize)
+	metrics.RegisterCache("auth_token", tokenCache.Stats)
+	srv := server.NewAdminServer(db, authClient, orgAccess, tokenCache)
 
-	// Initialize Auth Interceptor
-	authInterceptor := server.NewAuthInterceptor(db, authClient)
+	// Initialize the access checks run before every RPC
+	verifier, err := newVerifier(ctx, cfg.Auth, authClient)
+	if err != nil {
+		fatal("failed to initialize token verification", err)
+	}
+	authenticator := server.NewAuthenticator(verifier, tokenCache)
+	userSync := server.NewUserSync(db, tokenCache)
+	authorizer := server.NewAuthorizer()
+	tenancy := server.NewTenancy(orgAccess)
 
 	// 4. Start gRPC Server
-	lis, err := net.Listen("tcp", ":"+port)
+	addr := ":" + strconv.Itoa(cfg.Server.Port)
+	lis, err := net.Listen("tcp", addr)
 	if err != nil {
-		log.Fatalf("Failed to listen: %v", err)
+		fatal("failed to listen", err)
 	}
 
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(authInterceptor.Unary()),
+		tracing.ServerOption(),
+		grpc.ChainUnaryInterceptor(
+			server.LoggingUnaryInterceptor(),
+			metrics.UnaryServerInterceptor(),
+			authenticator.Unary(),
+			userSync.Unary(),
+			authorizer.Unary(),
+			tenancy.Unary(),
+			server.ValidationUnaryInterceptor(),
+			server.ReplicaUnaryInterceptor(),
+			server.ErrorUnaryInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			server.LoggingStreamInterceptor(),
+			metrics.StreamServerInterceptor(),
+			authenticator.Stream(),
+			userSync.Stream(),
+			authorizer.Stream(),
+			tenancy.Stream(),
+			server.ValidationStreamInterceptor(),
+			server.ReplicaStreamInterceptor(),
+			server.ErrorStreamInterceptor(),
+		),
 	)
 	adminpb.RegisterAdminServiceServer(grpcServer, srv)
 
+	// Readiness follows Postgres and the auth service
+	healthChecks := server.NewHealth(db, authConn)
+	healthpb.RegisterHealthServer(grpcServer, healthChecks.GRPC())
+	go healthChecks.Watch(ctx, healthCheckInterval)
+
 	// Register reflection for debugging (grpcurl)
-	reflection.Register(grpcServer)
+	if cfg.Features.Reflection {
+		reflection.Register(grpcServer)
+	}
 
 	// 5. Wrap gRPC with gRPC-web
 	wrappedGrpc := grpcweb.WrapServer(grpcServer,
 		grpcweb.WithOriginFunc(func(origin string) bool { return true }), // CORS handles origin validation
 		grpcweb.WithAllowedRequestHeaders([]string{
 			"Origin", "Content-Type", "Accept", "Authorization", "organization_id", "Organization_id", "organization-id", "Organization-Id",
-			"X-Grpc-Web", "X-User-Agent", "Grpc-Timeout",
+			"X-Request-Id", "X-Grpc-Web", "X-User-Agent", "Grpc-Timeout",
 			"Connect-Protocol-Version", "Connect-Timeout-Ms", "Connect-Content-Encoding", "Connect-Accept-Encoding",
 		}),
 	)
 
-	// CORS configuration
-	allowedOriginsStr := os.Getenv("ALLOWED_ORIGINS")
-	allowedOriginsStr = strings.TrimSpace(allowedOriginsStr)
-	allowedOriginsStr = strings.Trim(allowedOriginsStr, "\"'")
-	var allowedOrigins []string
-	if allowedOriginsStr != "" {
-		allowedOrigins = strings.Split(allowedOriginsStr, ",")
-		for i := range allowedOrigins {
-			allowedOrigins[i] = strings.TrimSpace(allowedOrigins[i])
-		}
-	}
-	baseDomain := os.Getenv("BASE_DOMAIN")
-	baseDomain = strings.TrimSpace(baseDomain)
-	baseDomain = strings.Trim(baseDomain, "\"'")
-
 	// Create the CORS handler
-	corsHandler := server.NewCORSHandler(allowedOrigins, baseDomain)
+	corsHandler := server.NewCORSHandler(cfg.CORS.AllowedOrigins, cfg.CORS.BaseDomain)
 
 	// Create the root handler that switches between gRPC-web and standard gRPC
 	rootHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		log.Printf("Received request: %s %s %s", r.Method, r.URL.Path, r.Proto)
-		for name, values := range r.Header {
-			for _, value := range values {
-				log.Printf("Header: %s: %s", name, value)
-			}
-		}
-
 		if wrappedGrpc.IsGrpcWebRequest(r) {
-			log.Println("Handling as gRPC-web request")
-			wrappedGrpc.ServeHTTP(w, r)
+			ctx := metrics.WithTransport(r.Context(), metrics.TransportGRPCWeb)
+			wrappedGrpc.ServeHTTP(w, r.WithContext(ctx))
 			return
 		}
 
-		log.Println("Handling as standard gRPC request")
 		grpcServer.ServeHTTP(w, r)
 	})
 
-	// Wrap rootHandler with CORS
-	handlerWithCORS := corsHandler(rootHandler)
+	// Wrap rootHandler with CORS and request tracking, so shutdown can drain
+	// it. Health endpoints stay reachable while draining.
+	mux := http.NewServeMux()
+	mux.Handle("/healthz", healthChecks.Liveness())
+	mux.Handle("/readyz", healthChecks.Readiness())
+	mux.Handle("/", healthChecks.Middleware(corsHandler(rootHandler)))
+
+	// Request logging sits inside h2c so that the upgraded HTTP/2 requests
+	// are logged as well.
+	handlerWithLogging := logging.HTTPMiddleware(mux)
 
 	// Wrap with h2c handler
-	handlerWithH2C := h2c.NewHandler(handlerWithCORS, &http2.Server{})
-
-	// Add final logging middleware for debugging
-	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		log.Printf("DEBUG: Incoming HTTP request: %s %s from %s", r.Method, r.URL.Path, r.RemoteAddr)
-		for name, values := range r.Header {
-			for _, value := range values {
-				log.Printf("  DEBUG: HTTP Header: %s: %s", name, value)
-			}
-		}
-		handlerWithH2C.ServeHTTP(w, r)
-	})
+	handler := h2c.NewHandler(handlerWithLogging, &http2.Server{})
 
-	log.Printf("Admin server starting on port %s (supporting gRPC, gRPC-web, and CORS)...", port)
 	httpServer := &http.Server{
-		Addr:    ":" + port,
-		Handler: handler,
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: cfg.Server.ReadHeaderTimeout,
+		IdleTimeout:       cfg.Server.IdleTimeout,
+	}
+	// With TLS, HTTP/2 is negotiated with ALPN; h2c still serves cleartext
+	// HTTP/2 when TLS is off
+	if cfg.Server.TLS.Enabled() {
+		cert, err := tlsutil.NewCertReloader(cfg.Server.TLS.CertFile, cfg.Server.TLS.KeyFile)
+		if err != nil {
+			fatal("failed to load server certificate", err)
+		}
+		go cert.Watch(ctx, certReloadInterval)
+		httpServer.TLSConfig = tlsutil.ServerConfig(cert)
+	}
+
+	slog.Info("admin server starting (supporting gRPC, gRPC-web, and CORS)",
+		"port", cfg.Server.Port, "tls", cfg.Server.TLS.Enabled())
+	serveErr := make(chan error, 2)
+	go func() {
+		if cfg.Server.TLS.Enabled() {
+			serveErr <- httpServer.ServeTLS(lis, "", "")
+			return
+		}
+		serveErr <- httpServer.Serve(lis)
+	}()
+
+	// Metrics have no authentication, so they are served apart from the
+	// API, on localhost unless configured otherwise
+	var metricsServer *http.Server
+	if cfg.Metrics.Addr != "" {
+		metricsLis, err := net.Listen("tcp", cfg.Metrics.Addr)
+		if err != nil {
+			fatal("failed to listen for metrics", err)
+		}
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", metrics.Handler())
+		metricsServer = &http.Server{
+			Handler:           metricsMux,
+			ReadHeaderTimeout: cfg.Server.ReadHeaderTimeout,
+			IdleTimeout:       cfg.Server.IdleTimeout,
+		}
+		slog.Info("metrics server starting", "addr", metricsLis.Addr().String())
+		go func() {
+			serveErr <- fmt.Errorf("metrics: %w", metricsServer.Serve(metricsLis))
+		}()
+	}
+
+	select {
+	case err := <-serveErr:
+		fatal("failed to serve", err)
+	case <-ctx.Done():
+	}
+	stop()
+
+	// Fail readiness and let in-flight RPCs finish before closing connections
+	slog.Info("shutting down", "timeout", cfg.Server.ShutdownTimeout.String())
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+	if err := healthChecks.Drain(shutdownCtx); err != nil {
+		slog.Warn("shutdown deadline reached before requests finished", "error", err)
+	}
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		httpServer.Close()
+	}
+	if metricsServer != nil {
+		if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+			metricsServer.Close()
+		}
+	}
+	grpcServer.Stop()
+	if sqlDB, err := db.DB(); err == nil {
+		sqlDB.Close()
+	}
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		slog.Warn("failed to flush traces", "error", err)
+	}
+	slog.Info("server stopped")
+}
+
+// fatal logs err and exits.
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
+
+// authTransportCredentials returns the credentials of the auth service
+// connection: plaintext unless auth.tls.enabled, then TLS verified against
+// auth.tls.ca_file (or the system roots), presenting auth.tls.cert_file for
+// mutual TLS when set.
+func authTransportCredentials(ctx context.Context, cfg config.ClientTLSConfig) (credentials.TransportCredentials, error) {
+	if !cfg.Enabled {
+		return insecure.NewCredentials(), nil
+	}
+	var cert *tlsutil.CertReloader
+	if cfg.CertFile != "" {
+		var err error
+		if cert, err = tlsutil.NewCertReloader(cfg.CertFile, cfg.KeyFile); err != nil {
+			return nil, err
+		}
+		go cert.Watch(ctx, certReloadInterval)
+	}
+	tlsCfg, err := tlsutil.ClientConfig(cfg.CAFile, cfg.ServerName, cert)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(tlsCfg), nil
+}
+
+// newVerifier picks how access tokens are checked from auth.verify_mode:
+//
+//	remote              every token is sent to the auth service (default)
+//	local               tokens are checked against auth.jwks_file or auth.jwks_url
+//	local_with_fallback as local, asking the auth service when no key matches
+//
+// Keys fetched from auth.jwks_url are refreshed every auth.jwks_refresh.
+// auth.issuer and auth.audience, when set, must match the token's iss and
+// aud claims.
+func newVerifier(ctx context.Context, cfg config.AuthConfig, authClient authpb.OAuthClient) (authn.Verifier, error) {
+	remote := authn.NewRemoteVerifier(authClient)
+	if cfg.VerifyMode == config.VerifyRemote {
+		return remote, nil
+	}
+
+	var keys *authn.KeySet
+	var err error
+	if cfg.JWKSFile != "" {
+		keys, err = authn.LoadKeySetFile(cfg.JWKSFile)
+	} else if keys, err = authn.NewRemoteKeySet(ctx, cfg.JWKSURL); err == nil {
+		go keys.Refresh(ctx, cfg.JWKSRefresh)
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	log.Printf("Admin server starting on port %s (supporting gRPC, gRPC-web, and CORS)...", port)
-	if err := httpServer.Serve(lis); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+	local := authn.NewLocalVerifier(keys, cfg.Issuer, cfg.Audience)
+	slog.Info("verifying access tokens locally", "mode", cfg.VerifyMode)
+	if cfg.VerifyMode == config.VerifyLocalWithFallback {
+		return &authn.FallbackVerifier{Local: local, Remote: remote}, nil
 	}
+	return local, nil
 }
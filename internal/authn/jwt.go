@@ -0,0 +1,83 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// LocalVerifier validates access tokens against the auth service's signing
+// keys without a network round-trip.
+type LocalVerifier struct {
+	Keys     *KeySet
+	Issuer   string
+	Audience string
+	Leeway   time.Duration
+}
+
+func NewLocalVerifier(keys *KeySet, issuer, audience string) *LocalVerifier {
+	return &LocalVerifier{
+		Keys:     keys,
+		Issuer:   issuer,
+		Audience: audience,
+		Leeway:   5 * time.Second,
+	}
+}
+
+type accessClaims struct {
+	jwt.RegisteredClaims
+	Email string `json:"email"`
+	Name  string `json:"name"`
+	Uuid  string `json:"uuid"`
+	Type  string `json:"typ"`
+}
+
+func (v *LocalVerifier) Verify(ctx context.Context, accessToken string) (*Identity, error) {
+	opts := []jwt.ParserOption{
+		jwt.WithLeeway(v.Leeway),
+		jwt.WithExpirationRequired(),
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512", "HS256", "HS384", "HS512"}),
+	}
+	if v.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(v.Issuer))
+	}
+	if v.Audience != "" {
+		opts = append(opts, jwt.WithAudience(v.Audience))
+	}
+
+	var claims accessClaims
+	_, err := jwt.ParseWithClaims(accessToken, &claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return v.Keys.Key(kid, t.Method.Alg())
+	}, opts...)
+	if err != nil {
+		if errors.Is(err, ErrKeyUnavailable) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	// Refresh tokens are signed with the same keys but must not grant access
+	if claims.Type != "" && claims.Type != "access" {
+		return nil, fmt.Errorf("%w: unexpected token type %q", ErrInvalidToken, claims.Type)
+	}
+
+	// The auth service puts the email in the subject
+	email := claims.Email
+	if email == "" {
+		email = claims.Subject
+	}
+	if email == "" {
+		return nil, fmt.Errorf("%w: token has no subject", ErrInvalidToken)
+	}
+
+	return &Identity{
+		Email: email,
+		Name:  claims.Name,
+		Uuid:  claims.Uuid,
+		Exp:   claims.ExpiresAt.Unix(),
+	}, nil
+}
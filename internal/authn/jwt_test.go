@@ -0,0 +1,162 @@
+package authn
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func writeJWKS(t *testing.T, kid string, key *rsa.PublicKey) string {
+	t.Helper()
+	doc := map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": kid,
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func writePEM(t *testing.T, keys ...*rsa.PublicKey) string {
+	t.Helper()
+	var data []byte
+	for _, key := range keys {
+		der, err := x509.MarshalPKIXPublicKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data = append(data, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})...)
+	}
+	path := filepath.Join(t.TempDir(), "keys.pem")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func signToken(t *testing.T, key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	s, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+type stubVerifier struct{ calls int }
+
+func (s *stubVerifier) Verify(ctx context.Context, accessToken string) (*Identity, error) {
+	s.calls++
+	return &Identity{Email: "remote@example.com"}, nil
+}
+
+func TestLocalVerifier(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := LoadKeySetFile(writeJWKS(t, "k1", &key.PublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := NewLocalVerifier(keys, "auth", "admin")
+	exp := time.Now().Add(time.Hour).Unix()
+
+	valid := jwt.MapClaims{"sub": "a@example.com", "uuid": "u-1", "name": "A", "typ": "access", "iss": "auth", "aud": "admin", "exp": exp}
+	id, err := v.Verify(context.Background(), signToken(t, key, "k1", valid))
+	if err != nil {
+		t.Fatalf("valid token rejected: %v", err)
+	}
+	if id.Email != "a@example.com" || id.Uuid != "u-1" || id.Exp != exp {
+		t.Fatalf("unexpected identity %+v", id)
+	}
+
+	invalid := map[string]jwt.MapClaims{
+		"expired":       {"sub": "a@example.com", "iss": "auth", "aud": "admin", "exp": time.Now().Add(-time.Hour).Unix()},
+		"wrong issuer":  {"sub": "a@example.com", "iss": "other", "aud": "admin", "exp": exp},
+		"wrong aud":     {"sub": "a@example.com", "iss": "auth", "aud": "other", "exp": exp},
+		"refresh token": {"sub": "a@example.com", "iss": "auth", "aud": "admin", "exp": exp, "typ": "refresh"},
+	}
+	for name, claims := range invalid {
+		if _, err := v.Verify(context.Background(), signToken(t, key, "k1", claims)); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("%s: expected ErrInvalidToken, got %v", name, err)
+		}
+	}
+
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.Verify(context.Background(), signToken(t, other, "k1", valid)); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("bad signature: expected ErrInvalidToken, got %v", err)
+	}
+
+	// Only an unknown key falls back to the auth service
+	remote := &stubVerifier{}
+	fb := &FallbackVerifier{Local: v, Remote: remote}
+	if id, err := fb.Verify(context.Background(), signToken(t, key, "k2", valid)); err != nil || id.Email != "remote@example.com" {
+		t.Fatalf("expected remote fallback, got %+v, %v", id, err)
+	}
+	if _, err := fb.Verify(context.Background(), signToken(t, key, "k1", invalid["expired"])); !errors.Is(err, ErrInvalidToken) || remote.calls != 1 {
+		t.Fatalf("invalid token must not fall back, got %v after %d remote calls", err, remote.calls)
+	}
+}
+
+// PEM keys have no kid, so any kid in the token tries every key.
+func TestLocalVerifierPEM(t *testing.T) {
+	first, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := LoadKeySetFile(writePEM(t, &first.PublicKey, &second.PublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := NewLocalVerifier(keys, "", "")
+	valid := jwt.MapClaims{"sub": "a@example.com", "exp": time.Now().Add(time.Hour).Unix()}
+
+	for _, kid := range []string{"", "0", "key-2026"} {
+		for i, key := range []*rsa.PrivateKey{first, second} {
+			if _, err := v.Verify(context.Background(), signToken(t, key, kid, valid)); err != nil {
+				t.Errorf("kid %q, key %d: %v", kid, i, err)
+			}
+		}
+	}
+
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.Verify(context.Background(), signToken(t, other, "key-2026", valid)); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("unknown key: expected ErrInvalidToken, got %v", err)
+	}
+}
@@ -0,0 +1,302 @@
+package authn
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// KeySet holds the public (or shared HMAC) keys the auth service signs
+// access tokens with. Keys are looked up by the token's "kid" header; a set
+// with a single key also accepts tokens without one. PEM keys have no key
+// id, so every PEM key that suits the token's algorithm is tried instead.
+type KeySet struct {
+	mu   sync.RWMutex
+	keys map[string]interface{}
+	// anyKid is set for PEM keys, which are matched regardless of kid.
+	anyKid bool
+
+	url    string
+	client *http.Client
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	K   string `json:"k"`
+}
+
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+// LoadKeySetFile reads a JWKS document or PEM encoded public keys from path.
+func LoadKeySetFile(path string) (*KeySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key set: %w", err)
+	}
+	keys, anyKid, err := parseKeys(data)
+	if err != nil {
+		return nil, err
+	}
+	return &KeySet{keys: keys, anyKid: anyKid}, nil
+}
+
+// NewRemoteKeySet fetches a JWKS document from url. The initial fetch must
+// succeed; call Refresh to keep the keys current.
+func NewRemoteKeySet(ctx context.Context, url string) (*KeySet, error) {
+	ks := &KeySet{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+	if err := ks.fetch(ctx); err != nil {
+		return nil, err
+	}
+	return ks, nil
+}
+
+// Refresh re-fetches a remote key set every interval until ctx is done. A
+// failed fetch keeps the previous keys so a short auth service outage does
+// not reject valid tokens.
+func (ks *KeySet) Refresh(ctx context.Context, interval time.Duration) {
+	if ks.url == "" || interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := ks.fetch(ctx); err != nil {
+				slog.Warn("failed to refresh signing keys", "url", ks.url, "error", err)
+			}
+		}
+	}
+}
+
+func (ks *KeySet) fetch(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ks.url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build key set request: %w", err)
+	}
+	resp, err := ks.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch key set: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch key set: unexpected status %s", resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("failed to read key set: %w", err)
+	}
+	keys, anyKid, err := parseKeys(data)
+	if err != nil {
+		return err
+	}
+	ks.mu.Lock()
+	ks.keys = keys
+	ks.anyKid = anyKid
+	ks.mu.Unlock()
+	return nil
+}
+
+// Key returns the key for kid, checking that it suits the signing algorithm.
+// For PEM keys the kid is ignored and every key that suits the algorithm is
+// returned as a jwt.VerificationKeySet.
+func (ks *KeySet) Key(kid, alg string) (interface{}, error) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	if ks.anyKid {
+		var set jwt.VerificationKeySet
+		for _, key := range ks.keys {
+			if suits(key, alg) {
+				set.Keys = append(set.Keys, key)
+			}
+		}
+		if len(set.Keys) == 0 {
+			return nil, fmt.Errorf("no key can verify %s signatures", alg)
+		}
+		return set, nil
+	}
+
+	key, ok := ks.keys[kid]
+	if !ok && kid == "" && len(ks.keys) == 1 {
+		for _, k := range ks.keys {
+			key, ok = k, true
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown key id %q", ErrKeyUnavailable, kid)
+	}
+
+	if !suits(key, alg) {
+		return nil, fmt.Errorf("key %q cannot verify %s signatures", kid, alg)
+	}
+	return key, nil
+}
+
+// suits reports whether key can verify signatures made with alg.
+func suits(key interface{}, alg string) bool {
+	switch key.(type) {
+	case *rsa.PublicKey:
+		return strings.HasPrefix(alg, "RS") || strings.HasPrefix(alg, "PS")
+	case *ecdsa.PublicKey:
+		return strings.HasPrefix(alg, "ES")
+	case []byte:
+		return strings.HasPrefix(alg, "HS")
+	default:
+		return false
+	}
+}
+
+// parseKeys parses a JWKS document or PEM keys. anyKid is true for PEM
+// keys, which have no key ids.
+func parseKeys(data []byte) (keys map[string]interface{}, anyKid bool, err error) {
+	trimmed := strings.TrimSpace(string(data))
+	if strings.HasPrefix(trimmed, "-----BEGIN") {
+		keys, err := parsePEMKeys([]byte(trimmed))
+		return keys, true, err
+	}
+
+	var set jwks
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, false, fmt.Errorf("failed to parse key set: %w", err)
+	}
+	keys = make(map[string]interface{}, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to parse key %q: %w", k.Kid, err)
+		}
+		keys[k.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, false, errors.New("key set contains no signing keys")
+	}
+	return keys, false, nil
+}
+
+// parsePEMKeys accepts one or more PEM public keys or certificates. PEM
+// carries no key ids, so keys are stored by position ("0", "1", ...); see
+// KeySet.Key for how they are matched.
+func parsePEMKeys(data []byte) (map[string]interface{}, error) {
+	keys := make(map[string]interface{})
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		var key interface{}
+		switch block.Type {
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse certificate: %w", err)
+			}
+			key = cert.PublicKey
+		case "PUBLIC KEY":
+			k, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse public key: %w", err)
+			}
+			key = k
+		case "RSA PUBLIC KEY":
+			k, err := x509.ParsePKCS1PublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse public key: %w", err)
+			}
+			key = k
+		default:
+			continue
+		}
+		keys[fmt.Sprint(len(keys))] = key
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no public keys found in PEM data")
+	}
+	return keys, nil
+}
+
+func (k jwk) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBase64URL(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBase64URL(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBase64URL(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBase64URL(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}, nil
+	case "oct":
+		return decodeBase64URL(k.K)
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+}
+
+func decodeBase64URL(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
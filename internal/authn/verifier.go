@@ -0,0 +1,70 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	oauthpb "github.com/gevorgmb/oauth/api/v1/pb/proto"
+)
+
+var (
+	ErrInvalidToken = errors.New("token is invalid")
+	// ErrKeyUnavailable means the token could not be checked locally because
+	// its signing key is unknown or the key set could not be loaded. Only this
+	// error lets a FallbackVerifier ask the auth service instead.
+	ErrKeyUnavailable = errors.New("signing key unavailable")
+)
+
+// Identity is what a verified access token says about its holder.
+type Identity struct {
+	Email string
+	Name  string
+	Uuid  string
+	// Exp is the token expiry as a Unix timestamp, or 0 if unknown.
+	Exp int64
+}
+
+type Verifier interface {
+	Verify(ctx context.Context, accessToken string) (*Identity, error)
+}
+
+// RemoteVerifier asks the OAuth service to verify every token.
+type RemoteVerifier struct {
+	Client oauthpb.OAuthClient
+}
+
+func NewRemoteVerifier(client oauthpb.OAuthClient) *RemoteVerifier {
+	return &RemoteVerifier{Client: client}
+}
+
+func (v *RemoteVerifier) Verify(ctx context.Context, accessToken string) (*Identity, error) {
+	resp, err := v.Client.Verify(ctx, &oauthpb.VerifyRequest{AccessToken: accessToken})
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify token: %w", err)
+	}
+	if !resp.Valid {
+		return nil, ErrInvalidToken
+	}
+	return &Identity{
+		Email: resp.Email,
+		Name:  resp.Name,
+		Uuid:  resp.GetUuid(),
+		Exp:   resp.Exp,
+	}, nil
+}
+
+// FallbackVerifier verifies tokens locally and calls Remote only when the
+// local key set cannot decide.
+type FallbackVerifier struct {
+	Local  Verifier
+	Remote Verifier
+}
+
+func (v *FallbackVerifier) Verify(ctx context.Context, accessToken string) (*Identity, error) {
+	id, err := v.Local.Verify(ctx, accessToken)
+	if errors.Is(err, ErrKeyUnavailable) {
+		return v.Remote.Verify(ctx, accessToken)
+	}
+	return id, err
+}
@@ -0,0 +1,147 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"persacc/internal/entity"
+	"persacc/internal/rbac"
+
+	"gorm.io/gorm"
+)
+
+// lockID serializes concurrent bootstraps, e.g. several replicas starting
+// with the on-start flag at once.
+const lockID int64 = 7_451_302_118_400_002
+
+// Run creates the permission catalogue and the default roles, and promotes
+// adminEmail (if not empty) to the admin role. It only adds missing rows and
+// permissions, so it is safe to run on every start.
+func Run(ctx context.Context, db *gorm.DB, adminEmail string) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", lockID).Error; err != nil {
+			return fmt.Errorf("failed to acquire bootstrap lock: %w", err)
+		}
+
+		perms := make(map[string]entity.Permission, len(rbac.Catalogue))
+		for _, p := range rbac.Catalogue {
+			perm, err := ensurePermission(ctx, tx, p)
+			if err != nil {
+				return err
+			}
+			perms[perm.Name] = perm
+		}
+
+		roles := make(map[string]entity.Role, len(rbac.DefaultRolePermissions))
+		for name, permNames := range rbac.DefaultRolePermissions {
+			role, err := ensureRole(ctx, tx, name, permNames, perms)
+			if err != nil {
+				return err
+			}
+			roles[name] = role
+		}
+
+		if adminEmail != "" {
+			if err := promoteAdmin(ctx, tx, adminEmail, roles[rbac.RoleAdmin]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ensurePermission creates the permission, or restores it if it was deleted.
+func ensurePermission(ctx context.Context, tx *gorm.DB, p entity.Permission) (entity.Permission, error) {
+	var perm entity.Permission
+	err := tx.Unscoped().Where("name = ?", p.Name).First(&perm).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		perm = entity.Permission{Name: p.Name, Description: p.Description}
+		if err := tx.Create(&perm).Error; err != nil {
+			return perm, fmt.Errorf("failed to create permission %q: %w", p.Name, err)
+		}
+		slog.InfoContext(ctx, "bootstrap: created permission", "permission", p.Name)
+		return perm, nil
+	} else if err != nil {
+		return perm, err
+	}
+
+	if perm.DeletedAt.Valid {
+		if err := tx.Unscoped().Model(&perm).Update("deleted_at", nil).Error; err != nil {
+			return perm, fmt.Errorf("failed to restore permission %q: %w", p.Name, err)
+		}
+		slog.InfoContext(ctx, "bootstrap: restored permission", "permission", p.Name)
+	}
+	return perm, nil
+}
+
+// ensureRole creates the role if needed and grants it any of permNames it
+// does not have yet. Permissions granted by hand are left alone.
+func ensureRole(ctx context.Context, tx *gorm.DB, name string, permNames []string, perms map[string]entity.Permission) (entity.Role, error) {
+	var role entity.Role
+	err := tx.Unscoped().Preload("Permissions").Where("name = ?", name).First(&role).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		role = entity.Role{Name: name}
+		if err := tx.Create(&role).Error; err != nil {
+			return role, fmt.Errorf("failed to create role %q: %w", name, err)
+		}
+		slog.InfoContext(ctx, "bootstrap: created role", "role", name)
+	} else if err != nil {
+		return role, err
+	} else if role.DeletedAt.Valid {
+		if err := tx.Unscoped().Model(&role).Update("deleted_at", nil).Error; err != nil {
+			return role, fmt.Errorf("failed to restore role %q: %w", name, err)
+		}
+		slog.InfoContext(ctx, "bootstrap: restored role", "role", name)
+	}
+
+	has := make(map[int64]bool, len(role.Permissions))
+	for _, p := range role.Permissions {
+		has[p.ID] = true
+	}
+	var missing []entity.Permission
+	for _, n := range permNames {
+		if p := perms[n]; !has[p.ID] {
+			missing = append(missing, p)
+		}
+	}
+	if len(missing) > 0 {
+		if err := tx.Model(&role).Association("Permissions").Append(missing); err != nil {
+			return role, fmt.Errorf("failed to grant permissions to role %q: %w", name, err)
+		}
+		slog.InfoContext(ctx, "bootstrap: granted permissions", "role", name, "count", len(missing))
+	}
+	return role, nil
+}
+
+// promoteAdmin gives the user with the email the admin role, creating the
+// user if it has not signed in yet or restoring it if it was deleted. The
+// account is linked to its OAuth identity by email on first sign-in.
+func promoteAdmin(ctx context.Context, tx *gorm.DB, email string, admin entity.Role) error {
+	var user entity.User
+	err := tx.Unscoped().Where("email = ?", email).First(&user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		user = entity.User{Email: email, RoleID: admin.ID}
+		if err := tx.Create(&user).Error; err != nil {
+			return fmt.Errorf("failed to create admin user %q: %w", email, err)
+		}
+		slog.InfoContext(ctx, "bootstrap: created admin user", "email", email)
+		return nil
+	} else if err != nil {
+		return err
+	} else if user.DeletedAt.Valid {
+		if err := tx.Unscoped().Model(&user).Update("deleted_at", nil).Error; err != nil {
+			return fmt.Errorf("failed to restore admin user %q: %w", email, err)
+		}
+		slog.InfoContext(ctx, "bootstrap: restored admin user", "email", email)
+	}
+
+	if user.RoleID != admin.ID {
+		if err := tx.Model(&user).Update("role_id", admin.ID).Error; err != nil {
+			return fmt.Errorf("failed to promote %q to admin: %w", email, err)
+		}
+		slog.InfoContext(ctx, "bootstrap: promoted user to admin", "email", email)
+	}
+	return nil
+}
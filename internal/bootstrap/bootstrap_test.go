@@ -0,0 +1,60 @@
+package bootstrap
+
+import (
+	"context"
+	"testing"
+
+	"persacc/internal/data/datatest"
+	"persacc/internal/entity"
+	"persacc/internal/rbac"
+)
+
+func TestRunRestoresDeletedAdmin(t *testing.T) {
+	db := datatest.Postgres(t)
+	ctx := context.Background()
+	const email = "admin@example.com"
+
+	run := func() {
+		t.Helper()
+		if err := Run(ctx, db, email); err != nil {
+			t.Fatal(err)
+		}
+	}
+	admin := func() entity.User {
+		t.Helper()
+		var u entity.User
+		if err := db.Unscoped().Preload("Role").Where("email = ?", email).First(&u).Error; err != nil {
+			t.Fatal(err)
+		}
+		return u
+	}
+	rows := func() [3]int64 {
+		t.Helper()
+		var n [3]int64
+		for i, table := range []string{"permissions", "roles", "role_permissions"} {
+			if err := db.Table(table).Count(&n[i]).Error; err != nil {
+				t.Fatal(err)
+			}
+		}
+		return n
+	}
+
+	run()
+	first, before := admin(), rows()
+	run()
+	if got := admin(); got.ID != first.ID || got.RoleID != first.RoleID || !got.UpdatedAt.Equal(first.UpdatedAt) {
+		t.Errorf("a second run changed the admin from %+v to %+v", first, got)
+	}
+	if after := rows(); after != before {
+		t.Errorf("a second run changed the row counts from %v to %v", before, after)
+	}
+
+	if err := db.Delete(&first).Error; err != nil {
+		t.Fatal(err)
+	}
+	run()
+	got := admin()
+	if got.ID != first.ID || got.DeletedAt.Valid || got.Role.Name != rbac.RoleAdmin {
+		t.Errorf("after deleting the admin, Run left %+v, want user %d restored as %s", got, first.ID, rbac.RoleAdmin)
+	}
+}
@@ -0,0 +1,142 @@
+package cache
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type item[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// TTL is a small, size-bounded in-process cache whose entries expire after a
+// fixed lifetime. It is safe for concurrent use.
+type TTL[K comparable, V any] struct {
+	mu         sync.Mutex
+	items      map[K]item[V]
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+	hits       atomic.Uint64
+	misses     atomic.Uint64
+}
+
+// Stats is a snapshot of cache usage.
+type Stats struct {
+	Hits    uint64
+	Misses  uint64
+	Entries int
+}
+
+func NewTTL[K comparable, V any](ttl time.Duration, maxEntries int) *TTL[K, V] {
+	return &TTL[K, V]{
+		items:      make(map[K]item[V]),
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		now:        time.Now,
+	}
+}
+
+func (c *TTL[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	it, ok := c.items[key]
+	if !ok {
+		c.misses.Add(1)
+		var zero V
+		return zero, false
+	}
+	if !c.now().Before(it.expiresAt) {
+		delete(c.items, key)
+		c.misses.Add(1)
+		var zero V
+		return zero, false
+	}
+	c.hits.Add(1)
+	return it.value, true
+}
+
+func (c *TTL[K, V]) Set(key K, value V) {
+	c.SetWithTTL(key, value, c.ttl)
+}
+
+// SetWithTTL stores value with a lifetime of its own, capped at the cache's
+// TTL. Non-positive lifetimes are not stored.
+func (c *TTL[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	if ttl > c.ttl {
+		ttl = c.ttl
+	}
+	if ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.items[key]; !exists && c.maxEntries > 0 && len(c.items) >= c.maxEntries {
+		c.evictLocked()
+	}
+	c.items[key] = item[V]{value: value, expiresAt: c.now().Add(ttl)}
+}
+
+func (c *TTL[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.items, key)
+}
+
+// DeleteFunc removes every entry for which match returns true.
+func (c *TTL[K, V]) DeleteFunc(match func(K, V) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, it := range c.items {
+		if match(k, it.value) {
+			delete(c.items, k)
+		}
+	}
+}
+
+// Clear removes every entry.
+func (c *TTL[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.items)
+}
+
+func (c *TTL[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.items)
+}
+
+func (c *TTL[K, V]) Stats() Stats {
+	return Stats{
+		Hits:    c.hits.Load(),
+		Misses:  c.misses.Load(),
+		Entries: c.Len(),
+	}
+}
+
+// evictLocked drops expired entries and, if the cache is still full, the entry
+// closest to expiry. The caller must hold c.mu.
+func (c *TTL[K, V]) evictLocked() {
+	now := c.now()
+	var oldestKey K
+	var oldest time.Time
+	found := false
+	for k, it := range c.items {
+		if !now.Before(it.expiresAt) {
+			delete(c.items, k)
+			continue
+		}
+		if !found || it.expiresAt.Before(oldest) {
+			oldestKey, oldest, found = k, it.expiresAt, true
+		}
+	}
+	if len(c.items) >= c.maxEntries && found {
+		delete(c.items, oldestKey)
+	}
+}
@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTTLExpiry(t *testing.T) {
+	now := time.Unix(1000, 0)
+	c := NewTTL[string, int](time.Minute, 0)
+	c.now = func() time.Time { return now }
+
+	c.Set("a", 1)
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Fatalf("Get(a) = %v, %v; want 1, true", v, ok)
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("Get(a) after expiry returned ok")
+	}
+	if c.Len() != 0 {
+		t.Fatalf("Len() = %d, want 0", c.Len())
+	}
+}
+
+func TestTTLBounded(t *testing.T) {
+	now := time.Unix(1000, 0)
+	c := NewTTL[int, int](time.Minute, 2)
+	c.now = func() time.Time { return now }
+
+	c.Set(1, 1)
+	now = now.Add(time.Second)
+	c.Set(2, 2)
+	now = now.Add(time.Second)
+	c.Set(3, 3)
+
+	if c.Len() != 2 {
+		t.Fatalf("Len() = %d, want 2", c.Len())
+	}
+	if _, ok := c.Get(1); ok {
+		t.Fatalf("oldest entry was not evicted")
+	}
+}
+
+func TestTTLDeleteFunc(t *testing.T) {
+	c := NewTTL[int, int](time.Minute, 0)
+	for i := 0; i < 5; i++ {
+		c.Set(i, i%2)
+	}
+	c.DeleteFunc(func(_ int, v int) bool { return v == 1 })
+	if c.Len() != 3 {
+		t.Fatalf("Len() = %d, want 3", c.Len())
+	}
+}
+
+func TestTTLSetWithTTLAndStats(t *testing.T) {
+	now := time.Unix(1000, 0)
+	c := NewTTL[string, int](time.Minute, 0)
+	c.now = func() time.Time { return now }
+
+	c.SetWithTTL("short", 1, 10*time.Second)
+	c.SetWithTTL("capped", 2, time.Hour)
+	c.SetWithTTL("expired", 3, -time.Second)
+
+	now = now.Add(30 * time.Second)
+	if _, ok := c.Get("short"); ok {
+		t.Error("entry outlived its own TTL")
+	}
+	if _, ok := c.Get("capped"); !ok {
+		t.Error("capped entry expired early")
+	}
+	now = now.Add(30 * time.Second)
+	if _, ok := c.Get("capped"); ok {
+		t.Error("entry outlived the cache TTL")
+	}
+	if _, ok := c.Get("expired"); ok {
+		t.Error("already expired entry was stored")
+	}
+
+	st := c.Stats()
+	if st.Hits != 1 || st.Misses != 3 {
+		t.Errorf("Stats() = %+v, want 1 hit and 3 misses", st)
+	}
+}
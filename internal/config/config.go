@@ -0,0 +1,439 @@
+// Package config loads the server configuration from defaults, an optional
+// YAML file and environment variables, in increasing order of precedence,
+// and validates it before anything is started.
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"persacc/internal/logging"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Token verification modes, see AuthConfig.VerifyMode.
+const (
+	VerifyRemote            = "remote"
+	VerifyLocal             = "local"
+	VerifyLocalWithFallback = "local_with_fallback"
+)
+
+// defaultDSN points at the local development database.
+const defaultDSN = "host=localhost user=postgres password=postgres dbname=persacc port=5452 sslmode=disable"
+
+type Config struct {
+	Server    ServerConfig    `yaml:"server"`
+	Database  DatabaseConfig  `yaml:"database"`
+	Auth      AuthConfig      `yaml:"auth"`
+	CORS      CORSConfig      `yaml:"cors"`
+	Log       LogConfig       `yaml:"log"`
+	Tracing   TracingConfig   `yaml:"tracing"`
+	Metrics   MetricsConfig   `yaml:"metrics"`
+	Bootstrap BootstrapConfig `yaml:"bootstrap"`
+	Features  FeatureFlags    `yaml:"features"`
+}
+
+type ServerConfig struct {
+	Port              int           `yaml:"port"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout"`
+	// ShutdownTimeout bounds how long in-flight requests are drained.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	TLS             TLSConfig     `yaml:"tls"`
+}
+
+// TLSConfig is the certificate the server presents. TLS is off while both
+// paths are empty. The files are read again when they change, so renewed
+// certificates need no restart.
+type TLSConfig struct {
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+}
+
+func (t TLSConfig) Enabled() bool {
+	return t.CertFile != "" || t.KeyFile != ""
+}
+
+type DatabaseConfig struct {
+	DSN string `yaml:"dsn"`
+	// ReplicaDSN, when set, serves the Get and List RPCs.
+	ReplicaDSN      string        `yaml:"replica_dsn"`
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time"`
+	// ConnectTimeout is how long startup waits for the database to accept
+	// connections. Zero tries once.
+	ConnectTimeout time.Duration `yaml:"connect_timeout"`
+	// StatementTimeout cancels statements running longer. Zero disables it.
+	StatementTimeout time.Duration `yaml:"statement_timeout"`
+}
+
+type AuthConfig struct {
+	Addr string          `yaml:"addr"`
+	TLS  ClientTLSConfig `yaml:"tls"`
+
+	VerifyMode  string        `yaml:"verify_mode"`
+	JWKSFile    string        `yaml:"jwks_file"`
+	JWKSURL     string        `yaml:"jwks_url"`
+	JWKSRefresh time.Duration `yaml:"jwks_refresh"`
+	Issuer      string        `yaml:"issuer"`
+	Audience    string        `yaml:"audience"`
+
+	TokenCacheTTL  time.Duration `yaml:"token_cache_ttl"`
+	TokenCacheSize int           `yaml:"token_cache_size"`
+}
+
+// ClientTLSConfig secures the connection to the auth service. CAFile
+// replaces the system roots; CertFile and KeyFile enable mutual TLS.
+type ClientTLSConfig struct {
+	Enabled    bool   `yaml:"enabled"`
+	CAFile     string `yaml:"ca_file"`
+	CertFile   string `yaml:"cert_file"`
+	KeyFile    string `yaml:"key_file"`
+	ServerName string `yaml:"server_name"`
+}
+
+type CORSConfig struct {
+	AllowedOrigins []string `yaml:"allowed_origins"`
+	BaseDomain     string   `yaml:"base_domain"`
+}
+
+type LogConfig struct {
+	Level  string `yaml:"level"`
+	Format string `yaml:"format"`
+}
+
+type TracingConfig struct {
+	// Exporter is "none", "otlp" or "stdout". The exporters read the
+	// standard OTEL_* variables for everything else.
+	Exporter string `yaml:"exporter"`
+}
+
+// MetricsConfig is where /metrics is served. It has its own listener so
+// that it is not exposed with the public API; an empty Addr disables it.
+type MetricsConfig struct {
+	Addr string `yaml:"addr"`
+}
+
+type BootstrapConfig struct {
+	OnStart    bool   `yaml:"on_start"`
+	AdminEmail string `yaml:"admin_email"`
+}
+
+type FeatureFlags struct {
+	// Reflection registers the gRPC reflection service for grpcurl.
+	Reflection bool `yaml:"reflection"`
+}
+
+// Default returns the configuration used for everything not set by the
+// file or the environment.
+func Default() Config {
+	return Config{
+		Server: ServerConfig{
+			Port:              8080,
+			ReadHeaderTimeout: 10 * time.Second,
+			IdleTimeout:       2 * time.Minute,
+			ShutdownTimeout:   30 * time.Second,
+		},
+		Database: DatabaseConfig{
+			DSN:              defaultDSN,
+			MaxOpenConns:     25,
+			MaxIdleConns:     10,
+			ConnMaxLifetime:  30 * time.Minute,
+			ConnMaxIdleTime:  5 * time.Minute,
+			ConnectTimeout:   time.Minute,
+			StatementTimeout: 30 * time.Second,
+		},
+		Auth: AuthConfig{
+			Addr:           "localhost:50061",
+			VerifyMode:     VerifyRemote,
+			JWKSRefresh:    15 * time.Minute,
+			TokenCacheTTL:  5 * time.Minute,
+			TokenCacheSize: 10000,
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: "json",
+		},
+		Tracing: TracingConfig{
+			Exporter: "none",
+		},
+		Metrics: MetricsConfig{
+			Addr: "127.0.0.1:9090",
+		},
+		Features: FeatureFlags{
+			Reflection: true,
+		},
+	}
+}
+
+// Load returns the default configuration overridden by the YAML file at
+// path, if path is not empty, and then by the environment. The result is
+// validated.
+func Load(path string) (*Config, error) {
+	cfg := Default()
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config file: %w", err)
+		}
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+		}
+	}
+	if err := errors.Join(cfg.loadEnv(os.LookupEnv), cfg.Validate()); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// loadEnv overrides cfg with the environment variables that are set.
+// Values are trimmed of surrounding whitespace and quotes.
+func (c *Config) loadEnv(lookup func(string) (string, bool)) error {
+	var errs []error
+	get := func(name string) (string, bool) {
+		v, _ := lookup(name)
+		v = strings.Trim(strings.TrimSpace(v), "\"'")
+		return v, v != ""
+	}
+	str := func(name string, dst *string) {
+		if v, ok := get(name); ok {
+			*dst = v
+		}
+	}
+	integer := func(name string, dst *int) {
+		if v, ok := get(name); ok {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %q is not a number", name, v))
+				return
+			}
+			*dst = n
+		}
+	}
+	duration := func(name string, dst *time.Duration) {
+		if v, ok := get(name); ok {
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %q is not a duration", name, v))
+				return
+			}
+			*dst = d
+		}
+	}
+	boolean := func(name string, dst *bool) {
+		if v, ok := get(name); ok {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %q is not a boolean", name, v))
+				return
+			}
+			*dst = b
+		}
+	}
+	list := func(name string, dst *[]string) {
+		if v, ok := get(name); ok {
+			*dst = nil
+			for _, item := range strings.Split(v, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					*dst = append(*dst, item)
+				}
+			}
+		}
+	}
+
+	integer("PORT", &c.Server.Port)
+	duration("READ_HEADER_TIMEOUT", &c.Server.ReadHeaderTimeout)
+	duration("IDLE_TIMEOUT", &c.Server.IdleTimeout)
+	duration("SHUTDOWN_TIMEOUT", &c.Server.ShutdownTimeout)
+	str("TLS_CERT_FILE", &c.Server.TLS.CertFile)
+	str("TLS_KEY_FILE", &c.Server.TLS.KeyFile)
+
+	str("DB_DSN", &c.Database.DSN)
+	str("DB_REPLICA_DSN", &c.Database.ReplicaDSN)
+	integer("DB_MAX_OPEN_CONNS", &c.Database.MaxOpenConns)
+	integer("DB_MAX_IDLE_CONNS", &c.Database.MaxIdleConns)
+	duration("DB_CONN_MAX_LIFETIME", &c.Database.ConnMaxLifetime)
+	duration("DB_CONN_MAX_IDLE_TIME", &c.Database.ConnMaxIdleTime)
+	duration("DB_CONNECT_TIMEOUT", &c.Database.ConnectTimeout)
+	duration("DB_STATEMENT_TIMEOUT", &c.Database.StatementTimeout)
+
+	str("AUTH_SERVICE_ADDR", &c.Auth.Addr)
+	boolean("AUTH_TLS", &c.Auth.TLS.Enabled)
+	str("AUTH_TLS_CA_FILE", &c.Auth.TLS.CAFile)
+	str("AUTH_TLS_CERT_FILE", &c.Auth.TLS.CertFile)
+	str("AUTH_TLS_KEY_FILE", &c.Auth.TLS.KeyFile)
+	str("AUTH_TLS_SERVER_NAME", &c.Auth.TLS.ServerName)
+	str("AUTH_VERIFY_MODE", &c.Auth.VerifyMode)
+	str("AUTH_JWKS_FILE", &c.Auth.JWKSFile)
+	str("AUTH_JWKS_URL", &c.Auth.JWKSURL)
+	duration("AUTH_JWKS_REFRESH", &c.Auth.JWKSRefresh)
+	str("AUTH_TOKEN_ISSUER", &c.Auth.Issuer)
+	str("AUTH_TOKEN_AUDIENCE", &c.Auth.Audience)
+	duration("AUTH_TOKEN_CACHE_TTL", &c.Auth.TokenCacheTTL)
+	integer("AUTH_TOKEN_CACHE_SIZE", &c.Auth.TokenCacheSize)
+
+	list("ALLOWED_ORIGINS", &c.CORS.AllowedOrigins)
+	str("BASE_DOMAIN", &c.CORS.BaseDomain)
+
+	str("LOG_LEVEL", &c.Log.Level)
+	str("LOG_FORMAT", &c.Log.Format)
+	str("OTEL_TRACES_EXPORTER", &c.Tracing.Exporter)
+	str("METRICS_ADDR", &c.Metrics.Addr)
+
+	boolean("BOOTSTRAP_ON_START", &c.Bootstrap.OnStart)
+	str("BOOTSTRAP_ADMIN_EMAIL", &c.Bootstrap.AdminEmail)
+
+	boolean("GRPC_REFLECTION", &c.Features.Reflection)
+
+	return errors.Join(errs...)
+}
+
+// Validate reports every invalid setting at once.
+func (c *Config) Validate() error {
+	var errs []error
+	fail := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+	positive := func(name string, d time.Duration) {
+		if d <= 0 {
+			fail("%s must be positive, got %s", name, d)
+		}
+	}
+	fileExists := func(name, path string) {
+		if path == "" {
+			return
+		}
+		if _, err := os.Stat(path); err != nil {
+			fail("%s: %v", name, err)
+		}
+	}
+
+	if c.Server.Port < 1 || c.Server.Port > 65535 {
+		fail("server.port must be between 1 and 65535, got %d", c.Server.Port)
+	}
+	positive("server.read_header_timeout", c.Server.ReadHeaderTimeout)
+	positive("server.idle_timeout", c.Server.IdleTimeout)
+	positive("server.shutdown_timeout", c.Server.ShutdownTimeout)
+	if c.Server.TLS.Enabled() && (c.Server.TLS.CertFile == "" || c.Server.TLS.KeyFile == "") {
+		fail("server.tls needs both cert_file and key_file")
+	}
+	fileExists("server.tls.cert_file", c.Server.TLS.CertFile)
+	fileExists("server.tls.key_file", c.Server.TLS.KeyFile)
+
+	if c.Database.DSN == "" {
+		fail("database.dsn is required")
+	}
+	if c.Database.MaxOpenConns < 0 || c.Database.MaxIdleConns < 0 {
+		fail("database connection pool sizes must not be negative")
+	}
+	if c.Database.MaxOpenConns > 0 && c.Database.MaxIdleConns > c.Database.MaxOpenConns {
+		fail("database.max_idle_conns (%d) must not exceed database.max_open_conns (%d)", c.Database.MaxIdleConns, c.Database.MaxOpenConns)
+	}
+	if c.Database.ConnMaxLifetime < 0 || c.Database.ConnMaxIdleTime < 0 {
+		fail("database connection lifetimes must not be negative")
+	}
+	if c.Database.ConnectTimeout < 0 || c.Database.StatementTimeout < 0 {
+		fail("database timeouts must not be negative")
+	}
+
+	if _, _, err := net.SplitHostPort(c.Auth.Addr); err != nil {
+		fail("auth.addr must be host:port, got %q", c.Auth.Addr)
+	}
+	if (c.Auth.TLS.CertFile == "") != (c.Auth.TLS.KeyFile == "") {
+		fail("auth.tls needs both cert_file and key_file for mutual TLS")
+	}
+	if !c.Auth.TLS.Enabled && (c.Auth.TLS.CAFile != "" || c.Auth.TLS.CertFile != "" || c.Auth.TLS.KeyFile != "") {
+		fail("auth.tls files are set but auth.tls.enabled is false")
+	}
+	fileExists("auth.tls.ca_file", c.Auth.TLS.CAFile)
+	fileExists("auth.tls.cert_file", c.Auth.TLS.CertFile)
+	fileExists("auth.tls.key_file", c.Auth.TLS.KeyFile)
+	switch c.Auth.VerifyMode {
+	case VerifyRemote:
+	case VerifyLocal, VerifyLocalWithFallback:
+		if c.Auth.JWKSFile == "" && c.Auth.JWKSURL == "" {
+			fail("auth.verify_mode %q needs auth.jwks_file or auth.jwks_url", c.Auth.VerifyMode)
+		}
+	default:
+		fail("auth.verify_mode must be %q, %q or %q, got %q", VerifyRemote, VerifyLocal, VerifyLocalWithFallback, c.Auth.VerifyMode)
+	}
+	fileExists("auth.jwks_file", c.Auth.JWKSFile)
+	if c.Auth.JWKSURL != "" {
+		if u, err := url.Parse(c.Auth.JWKSURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			fail("auth.jwks_url must be an http(s) URL, got %q", c.Auth.JWKSURL)
+		}
+	}
+	positive("auth.jwks_refresh", c.Auth.JWKSRefresh)
+	positive("auth.token_cache_ttl", c.Auth.TokenCacheTTL)
+	if c.Auth.TokenCacheSize < 1 {
+		fail("auth.token_cache_size must be at least 1, got %d", c.Auth.TokenCacheSize)
+	}
+
+	if _, err := logging.New(io.Discard, c.Log.Level, c.Log.Format); err != nil {
+		fail("log: %v", err)
+	}
+	switch c.Tracing.Exporter {
+	case "none", "otlp", "stdout":
+	default:
+		fail("tracing.exporter must be none, otlp or stdout, got %q", c.Tracing.Exporter)
+	}
+	if c.Metrics.Addr != "" {
+		if _, _, err := net.SplitHostPort(c.Metrics.Addr); err != nil {
+			fail("metrics.addr must be host:port, got %q", c.Metrics.Addr)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Redacted returns a copy that is safe to log: the database password is
+// removed.
+func (c Config) Redacted() Config {
+	c.Database.DSN = logging.RedactDSN(c.Database.DSN)
+	c.Database.ReplicaDSN = logging.RedactDSN(c.Database.ReplicaDSN)
+	c.CORS.AllowedOrigins = append([]string(nil), c.CORS.AllowedOrigins...)
+	return c
+}
+
+// String renders the redacted configuration as YAML.
+func (c Config) String() string {
+	out, err := yaml.Marshal(c.Redacted())
+	if err != nil {
+		return fmt.Sprintf("<invalid config: %v>", err)
+	}
+	return string(out)
+}
+
+// LogValue logs the redacted configuration, so a Config can be passed to
+// slog as is.
+func (c Config) LogValue() slog.Value {
+	out, err := yaml.Marshal(c.Redacted())
+	if err != nil {
+		return slog.StringValue(err.Error())
+	}
+	var tree map[string]interface{}
+	if err := yaml.Unmarshal(out, &tree); err != nil {
+		return slog.StringValue(err.Error())
+	}
+	return slog.AnyValue(tree)
+}
+
+// UsesDefaultDSN reports whether the database falls back to the local
+// development DSN.
+func (c Config) UsesDefaultDSN() bool {
+	return c.Database.DSN == defaultDSN
+}
@@ -0,0 +1,105 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadPrecedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	file := `
+server:
+  port: 9090
+  shutdown_timeout: 45s
+database:
+  max_open_conns: 50
+cors:
+  allowed_origins: [https://a.example.com]
+`
+	if err := os.WriteFile(path, []byte(file), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PORT", " '9191' ")
+	t.Setenv("ALLOWED_ORIGINS", "https://b.example.com, https://c.example.com")
+	t.Setenv("LOG_LEVEL", "")
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Server.Port != 9191 {
+		t.Errorf("port = %d, want the environment's 9191", cfg.Server.Port)
+	}
+	if cfg.Server.ShutdownTimeout != 45*time.Second {
+		t.Errorf("shutdown timeout = %s, want the file's 45s", cfg.Server.ShutdownTimeout)
+	}
+	if cfg.Database.MaxOpenConns != 50 || cfg.Database.MaxIdleConns != 10 {
+		t.Errorf("pool = %d/%d, want 50/10", cfg.Database.MaxOpenConns, cfg.Database.MaxIdleConns)
+	}
+	if got := strings.Join(cfg.CORS.AllowedOrigins, " "); got != "https://b.example.com https://c.example.com" {
+		t.Errorf("allowed origins = %q", got)
+	}
+	if cfg.Log.Level != "info" {
+		t.Errorf("empty LOG_LEVEL should keep the default, got %q", cfg.Log.Level)
+	}
+}
+
+func TestLoadRejectsUnknownFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("server:\n  prot: 80\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Fatal("expected an error for a misspelled field")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		want   string
+	}{
+		{"defaults", func(*Config) {}, ""},
+		{"port", func(c *Config) { c.Server.Port = 70000 }, "server.port"},
+		{"idle above open", func(c *Config) { c.Database.MaxIdleConns = 100 }, "max_idle_conns"},
+		{"auth addr", func(c *Config) { c.Auth.Addr = "localhost" }, "auth.addr"},
+		{"local without keys", func(c *Config) { c.Auth.VerifyMode = VerifyLocal }, "jwks"},
+		{"tls without key", func(c *Config) { c.Server.TLS.CertFile = "cert.pem" }, "server.tls"},
+		{"client tls disabled", func(c *Config) { c.Auth.TLS.ServerName = "auth"; c.Auth.TLS.CAFile = "ca.pem" }, "auth.tls.enabled"},
+		{"log format", func(c *Config) { c.Log.Format = "xml" }, "log"},
+		{"exporter", func(c *Config) { c.Tracing.Exporter = "zipkin" }, "tracing.exporter"},
+		{"metrics disabled", func(c *Config) { c.Metrics.Addr = "" }, ""},
+		{"metrics addr", func(c *Config) { c.Metrics.Addr = "9090" }, "metrics.addr"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			tt.modify(&cfg)
+			err := cfg.Validate()
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("error = %v, want one mentioning %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedacted(t *testing.T) {
+	cfg := Default()
+	cfg.Database.DSN = "postgres://app:s3cret@db:5432/persacc"
+	if out := cfg.String(); strings.Contains(out, "s3cret") {
+		t.Errorf("password leaked:\n%s", out)
+	}
+	if !strings.Contains(cfg.Database.DSN, "s3cret") {
+		t.Error("Redacted modified the original")
+	}
+}
@@ -2,16 +2,15 @@ package controller
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-	"gorm.io/gorm"
-
 	adminpb "persacc/api/v1/admin"
 	"persacc/internal/entity"
+	"persacc/internal/fieldmask"
+	"persacc/internal/listquery"
+	"persacc/internal/pagination"
+	"persacc/internal/principal"
 	"persacc/internal/service"
 )
 
@@ -36,9 +35,11 @@ func (c *CustomerController) Create(ctx context.Context, req *adminpb.CreateCust
 	}
 
 	if req.Birthday != "" {
-		if t, err := time.Parse("2006-01-02", req.Birthday); err == nil {
-			customer.Birthday = &t
+		t, err := time.Parse("2006-01-02", req.Birthday)
+		if err != nil {
+			return nil, service.NewValidationError("birthday", "must be a YYYY-MM-DD date")
 		}
+		customer.Birthday = &t
 	}
 
 	if len(req.AdditionalInfo) > 0 {
@@ -54,72 +55,106 @@ func (c *CustomerController) Create(ctx context.Context, req *adminpb.CreateCust
 		customer.UserID = &uid
 	}
 
-	orgId := ctx.Value("organization_id").(int64)
+	orgId, err := principal.OrganizationID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
+	userId, err := principal.UserID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
 
-	if err := c.Service.Create(ctx, &customer, orgId); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create customer: %v", err)
+	link := entity.OrganizationCustomer{
+		OrganizationID: orgId,
+		Customer:       customer,
+		Description:    req.Description,
+		Tags:           req.Tags,
+		Notes:          req.Notes,
+	}
+	if err := c.Service.Create(ctx, &link, userId); err != nil {
+		return nil, err
 	}
 
 	return &adminpb.CreateCustomerResponse{
-		Customer: ConvertCustomerToProto(customer),
+		Customer: ConvertCustomerToProto(link),
 	}, nil
 }
 
 func (c *CustomerController) Get(ctx context.Context, req *adminpb.GetCustomerRequest) (*adminpb.GetCustomerResponse, error) {
-	orgId := ctx.Value("organization_id").(int64)
-	customer, err := c.Service.Get(ctx, req.Id, orgId)
+	orgId, err := principal.OrganizationID(ctx)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "customer not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get customer: %v", err)
+		return nil, principalError(err)
+	}
+	link, err := c.Service.Get(ctx, req.Id, orgId)
+	if err != nil {
+		return nil, err
 	}
 
 	return &adminpb.GetCustomerResponse{
-		Customer: ConvertCustomerToProto(*customer),
+		Customer: ConvertCustomerToProto(*link),
 	}, nil
 }
 
 func (c *CustomerController) Update(ctx context.Context, req *adminpb.UpdateCustomerRequest) (*adminpb.UpdateCustomerResponse, error) {
-	orgId := ctx.Value("organization_id").(int64)
-	customer, err := c.Service.Get(ctx, req.Id, orgId)
+	mask, err := fieldmask.New(req.UpdateMask, "name", "first_name", "last_name", "prefix", "middle_name", "suffix", "birthday", "phone", "email", "additional_info", "description", "tags", "notes")
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "customer not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to find customer: %v", err)
+		return nil, err
+	}
+	orgId, err := principal.OrganizationID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
+	link, err := c.Service.Get(ctx, req.Id, orgId)
+	if err != nil {
+		return nil, err
 	}
+	before := *link
+	customer := &link.Customer
 
-	if req.Name != "" {
+	if mask.Updates("description", req.Description != "") {
+		link.Description = req.Description
+	}
+	if mask.Updates("tags", len(req.Tags) > 0) {
+		link.Tags = req.Tags
+	}
+	if mask.Updates("notes", req.Notes != "") {
+		link.Notes = req.Notes
+	}
+
+	if mask.Updates("name", req.Name != "") {
 		customer.Name = req.Name
 	}
-	if req.FirstName != "" {
+	if mask.Updates("first_name", req.FirstName != "") {
 		customer.FirstName = req.FirstName
 	}
-	if req.LastName != "" {
+	if mask.Updates("last_name", req.LastName != "") {
 		customer.LastName = req.LastName
 	}
-	if req.Prefix != "" {
+	if mask.Updates("prefix", req.Prefix != "") {
 		customer.Prefix = req.Prefix
 	}
-	if req.MiddleName != "" {
+	if mask.Updates("middle_name", req.MiddleName != "") {
 		customer.MiddleName = req.MiddleName
 	}
-	if req.Suffix != "" {
+	if mask.Updates("suffix", req.Suffix != "") {
 		customer.Suffix = req.Suffix
 	}
-	if req.Phone != "" {
+	if mask.Updates("phone", req.Phone != "") {
 		customer.Phone = req.Phone
 	}
-	if req.Email != "" {
+	if mask.Updates("email", req.Email != "") {
 		customer.Email = req.Email
 	}
-	if req.Birthday != "" {
-		if t, err := time.Parse("2006-01-02", req.Birthday); err == nil {
+	if mask.Updates("birthday", req.Birthday != "") {
+		if req.Birthday == "" {
+			customer.Birthday = nil
+		} else if t, err := time.Parse("2006-01-02", req.Birthday); err == nil {
 			customer.Birthday = &t
+		} else {
+			return nil, service.NewValidationError("birthday", "must be a YYYY-MM-DD date")
 		}
 	}
-	if len(req.AdditionalInfo) > 0 {
+	if mask.Updates("additional_info", len(req.AdditionalInfo) > 0) {
 		info := make(map[string]interface{}, len(req.AdditionalInfo))
 		for k, v := range req.AdditionalInfo {
 			info[k] = v
@@ -127,35 +162,33 @@ func (c *CustomerController) Update(ctx context.Context, req *adminpb.UpdateCust
 		customer.AdditionalInfo = info
 	}
 
-	if err := c.Service.Update(ctx, customer, orgId); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update customer: %v", err)
+	if err := c.Service.Update(ctx, &before, link, req.Version); err != nil {
+		return nil, err
 	}
 
 	return &adminpb.UpdateCustomerResponse{
-		Customer: ConvertCustomerToProto(*customer),
+		Customer: ConvertCustomerToProto(*link),
 	}, nil
 }
 
 func (c *CustomerController) Delete(ctx context.Context, req *adminpb.DeleteCustomerRequest) (*adminpb.DeleteCustomerResponse, error) {
-	orgId := ctx.Value("organization_id").(int64)
-	if err := c.Service.Delete(ctx, req.Id, orgId); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete customer: %v", err)
+	orgId, err := principal.OrganizationID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
+	if err := c.Service.Delete(ctx, req.Id, orgId, req.Version); err != nil {
+		return nil, err
 	}
 	return &adminpb.DeleteCustomerResponse{Success: true}, nil
 }
 
 func (c *CustomerController) List(ctx context.Context, req *adminpb.ListCustomersRequest) (*adminpb.ListCustomersResponse, error) {
-	limit := int(req.Limit)
-	if limit <= 0 {
-		limit = 10
-	}
-	page := int(req.Page)
-	if page <= 0 {
-		page = 1
-	}
-	offset := (page - 1) * limit
+	page := pagination.NewRequest(req.Page, req.Limit, req.PageToken)
 
-	orgId := ctx.Value("organization_id").(int64)
+	orgId, err := principal.OrganizationID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
 
 	filters := make(map[string]string)
 	if req.Name != "" {
@@ -171,25 +204,29 @@ func (c *CustomerController) List(ctx context.Context, req *adminpb.ListCustomer
 		filters["additional_info"] = req.AdditionalInfo
 	}
 
-	customers, total, err := c.Service.List(ctx, limit, offset, orgId, filters)
+	result, err := c.Service.List(ctx, page, listquery.Request{Filter: req.Filter, OrderBy: req.OrderBy}, orgId, filters)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list customers: %v", err)
+		return nil, err
 	}
 
 	var protoCustomers []*adminpb.Customer
-	for _, cu := range customers {
+	for _, cu := range result.Items {
 		protoCustomers = append(protoCustomers, ConvertCustomerToProto(cu))
 	}
 
 	return &adminpb.ListCustomersResponse{
-		Customers: protoCustomers,
-		Total:     int32(total),
-		Page:      int32(page),
-		Limit:     int32(limit),
+		Customers:     protoCustomers,
+		Total:         int32(result.Total),
+		Page:          int32(page.Page),
+		Limit:         int32(page.Limit),
+		NextPageToken: result.NextPageToken,
 	}, nil
 }
 
-func ConvertCustomerToProto(c entity.Customer) *adminpb.Customer {
+// ConvertCustomerToProto returns the customer of link as the organization
+// of link sees it.
+func ConvertCustomerToProto(link entity.OrganizationCustomer) *adminpb.Customer {
+	c := link.Customer
 	var birthday string
 	if c.Birthday != nil {
 		birthday = c.Birthday.Format("2006-01-02")
@@ -221,5 +258,9 @@ func ConvertCustomerToProto(c entity.Customer) *adminpb.Customer {
 		CreatedAt:      c.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:      c.UpdatedAt.Format(time.RFC3339),
 		DeletedAt:      c.DeletedAt.Time.Format(time.RFC3339),
+		Version:        link.Version,
+		Description:    link.Description,
+		Tags:           link.Tags,
+		Notes:          link.Notes,
 	}
 }
@@ -0,0 +1,17 @@
+package controller
+
+// optionalString maps an empty string to a NULL column value.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// optionalID maps a zero id to a NULL column value.
+func optionalID(id int64) *int64 {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
@@ -11,6 +11,11 @@ import (
 
 	adminpb "persacc/api/v1/admin"
 	"persacc/internal/entity"
+	"persacc/internal/fieldmask"
+	"persacc/internal/listquery"
+	"persacc/internal/pagination"
+	"persacc/internal/principal"
+	"persacc/internal/rbac"
 	"persacc/internal/service"
 )
 
@@ -22,15 +27,33 @@ func NewOrganizationController(service *service.OrganizationService) *Organizati
 	return &OrganizationController{Service: service}
 }
 
+// Create makes the caller the owner of the new organization. Only callers
+// allowed to manage users may name another owner with owner_id.
 func (c *OrganizationController) Create(ctx context.Context, req *adminpb.CreateOrganizationRequest) (*adminpb.CreateOrganizationResponse, error) {
+	p, err := principal.FromContext(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
+	if p.User.ID == 0 {
+		return nil, principalError(principal.ErrNoUser)
+	}
+	ownerID := p.User.ID
+	if req.OwnerId != 0 && req.OwnerId != ownerID {
+		if !p.HasPermission(rbac.UserWrite) {
+			return nil, status.Errorf(codes.PermissionDenied, "only users with the %s permission may create an organization for another owner", rbac.UserWrite)
+		}
+		ownerID = req.OwnerId
+	}
+
 	org := entity.Organization{
-		OwnerID:     req.OwnerId,
+		OwnerID:     ownerID,
 		Name:        req.Name,
 		Description: req.Description,
+		SKUPattern:  req.SkuPattern,
 	}
 
 	if err := c.Service.Create(ctx, &org); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create organization: %v", err)
+		return nil, err
 	}
 
 	return &adminpb.CreateOrganizationResponse{
@@ -46,7 +69,7 @@ func (c *OrganizationController) Get(ctx context.Context, req *adminpb.GetOrgani
 				Message: "organization does not added, add it now",
 			}, nil
 		}
-		return nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+		return nil, err
 	}
 
 	return &adminpb.GetOrganizationResponse{
@@ -55,23 +78,28 @@ func (c *OrganizationController) Get(ctx context.Context, req *adminpb.GetOrgani
 }
 
 func (c *OrganizationController) Update(ctx context.Context, req *adminpb.UpdateOrganizationRequest) (*adminpb.UpdateOrganizationResponse, error) {
+	mask, err := fieldmask.New(req.UpdateMask, "name", "description", "sku_pattern")
+	if err != nil {
+		return nil, err
+	}
 	org, err := c.Service.Get(ctx, req.Id)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "organization not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to find organization: %v", err)
+		return nil, err
 	}
+	before := *org
 
-	if req.Name != "" {
+	if mask.Updates("name", req.Name != "") {
 		org.Name = req.Name
 	}
-	if req.Description != "" {
+	if mask.Updates("description", req.Description != "") {
 		org.Description = req.Description
 	}
+	if mask.Updates("sku_pattern", req.SkuPattern != "") {
+		org.SKUPattern = req.SkuPattern
+	}
 
-	if err := c.Service.Update(ctx, org); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update organization: %v", err)
+	if err := c.Service.Update(ctx, &before, org, req.Version); err != nil {
+		return nil, err
 	}
 
 	return &adminpb.UpdateOrganizationResponse{
@@ -80,38 +108,36 @@ func (c *OrganizationController) Update(ctx context.Context, req *adminpb.Update
 }
 
 func (c *OrganizationController) Delete(ctx context.Context, req *adminpb.DeleteOrganizationRequest) (*adminpb.DeleteOrganizationResponse, error) {
-	if err := c.Service.Delete(ctx, req.Id); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete organization: %v", err)
+	if err := c.Service.Delete(ctx, req.Id, req.Version); err != nil {
+		return nil, err
 	}
 	return &adminpb.DeleteOrganizationResponse{Success: true}, nil
 }
 
 func (c *OrganizationController) List(ctx context.Context, req *adminpb.ListOrganizationsRequest) (*adminpb.ListOrganizationsResponse, error) {
-	limit := int(req.Limit)
-	if limit <= 0 {
-		limit = 10
-	}
-	page := int(req.Page)
-	if page <= 0 {
-		page = 1
+	page := pagination.NewRequest(req.Page, req.Limit, req.PageToken)
+
+	userId, err := principal.UserID(ctx)
+	if err != nil {
+		return nil, principalError(err)
 	}
-	offset := (page - 1) * limit
 
-	orgs, total, err := c.Service.List(ctx, limit, offset, ctx.Value("user_id").(int64))
+	result, err := c.Service.List(ctx, page, listquery.Request{Filter: req.Filter, OrderBy: req.OrderBy}, userId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list organizations: %v", err)
+		return nil, err
 	}
 
 	var protoOrgs []*adminpb.Organization
-	for _, o := range orgs {
+	for _, o := range result.Items {
 		protoOrgs = append(protoOrgs, ConvertOrganizationToProto(o))
 	}
 
 	return &adminpb.ListOrganizationsResponse{
 		Organizations: protoOrgs,
-		Total:         int32(total),
-		Page:          int32(page),
-		Limit:         int32(limit),
+		Total:         int32(result.Total),
+		Page:          int32(page.Page),
+		Limit:         int32(page.Limit),
+		NextPageToken: result.NextPageToken,
 	}, nil
 }
 
@@ -123,5 +149,7 @@ func ConvertOrganizationToProto(o entity.Organization) *adminpb.Organization {
 		Description: o.Description,
 		CreatedAt:   timestamppb.New(o.CreatedAt),
 		UpdatedAt:   timestamppb.New(o.UpdatedAt),
+		Version:     o.Version,
+		SkuPattern:  o.SKUPattern,
 	}
 }
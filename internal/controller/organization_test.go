@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	adminpb "persacc/api/v1/admin"
+	"persacc/internal/data/datatest"
+	"persacc/internal/entity"
+	"persacc/internal/principal"
+	"persacc/internal/rbac"
+	"persacc/internal/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func callerContext(id int64, permissions ...string) context.Context {
+	role := entity.Role{Name: "test"}
+	for _, name := range permissions {
+		role.Permissions = append(role.Permissions, entity.Permission{Name: name})
+	}
+	return principal.NewContext(context.Background(), &principal.Principal{User: entity.User{ID: id, Role: role}})
+}
+
+func TestCreateOrganizationOwner(t *testing.T) {
+	db, _ := datatest.DryRun(t)
+	c := NewOrganizationController(service.NewOrganizationService(db, nil))
+
+	tests := []struct {
+		ctx      context.Context
+		ownerID  int64
+		want     int64
+		wantCode codes.Code
+	}{
+		{callerContext(7, rbac.OrganizationWrite), 0, 7, codes.OK},
+		{callerContext(7, rbac.OrganizationWrite), 7, 7, codes.OK},
+		{callerContext(7, rbac.OrganizationWrite), 9, 0, codes.PermissionDenied},
+		{callerContext(7, rbac.OrganizationWrite, rbac.UserWrite), 9, 9, codes.OK},
+	}
+	for _, tt := range tests {
+		resp, err := c.Create(tt.ctx, &adminpb.CreateOrganizationRequest{OwnerId: tt.ownerID, Name: "Acme"})
+		if status.Code(err) != tt.wantCode {
+			t.Errorf("owner_id %d: got %v, want %v", tt.ownerID, err, tt.wantCode)
+			continue
+		}
+		if err == nil && resp.Organization.OwnerId != tt.want {
+			t.Errorf("owner_id %d: owner = %d, want %d", tt.ownerID, resp.Organization.OwnerId, tt.want)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	adminpb "persacc/api/v1/admin"
+	"persacc/internal/entity"
+	"persacc/internal/listquery"
+	"persacc/internal/pagination"
+	"persacc/internal/principal"
+	"persacc/internal/service"
+)
+
+type OrganizationUserController struct {
+	Service *service.OrganizationUserService
+}
+
+func NewOrganizationUserController(service *service.OrganizationUserService) *OrganizationUserController {
+	return &OrganizationUserController{Service: service}
+}
+
+func (c *OrganizationUserController) Add(ctx context.Context, req *adminpb.AddOrganizationUserRequest) (*adminpb.AddOrganizationUserResponse, error) {
+	if req.UserId == 0 && req.Email == "" {
+		return nil, service.NewValidationError("user_id", "either user_id or email is required")
+	}
+
+	actorId, err := principal.UserID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
+	member, err := c.Service.Add(ctx, actorId, req.OrganizationId, req.UserId, req.Email, req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminpb.AddOrganizationUserResponse{
+		Member: ConvertOrganizationUserToProto(*member),
+	}, nil
+}
+
+func (c *OrganizationUserController) List(ctx context.Context, req *adminpb.ListOrganizationUsersRequest) (*adminpb.ListOrganizationUsersResponse, error) {
+	page := pagination.NewRequest(req.Page, req.Limit, req.PageToken)
+
+	actorId, err := principal.UserID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
+	result, err := c.Service.List(ctx, actorId, req.OrganizationId, page, listquery.Request{Filter: req.Filter, OrderBy: req.OrderBy})
+	if err != nil {
+		return nil, err
+	}
+
+	var protoMembers []*adminpb.OrganizationUser
+	for _, m := range result.Items {
+		protoMembers = append(protoMembers, ConvertOrganizationUserToProto(m))
+	}
+
+	return &adminpb.ListOrganizationUsersResponse{
+		Members:       protoMembers,
+		Total:         int32(result.Total),
+		Page:          int32(page.Page),
+		Limit:         int32(page.Limit),
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+func (c *OrganizationUserController) UpdateRole(ctx context.Context, req *adminpb.UpdateOrganizationUserRoleRequest) (*adminpb.UpdateOrganizationUserRoleResponse, error) {
+	actorId, err := principal.UserID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
+	member, err := c.Service.UpdateRole(ctx, actorId, req.OrganizationId, req.UserId, req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminpb.UpdateOrganizationUserRoleResponse{
+		Member: ConvertOrganizationUserToProto(*member),
+	}, nil
+}
+
+func (c *OrganizationUserController) Remove(ctx context.Context, req *adminpb.RemoveOrganizationUserRequest) (*adminpb.RemoveOrganizationUserResponse, error) {
+	actorId, err := principal.UserID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
+	if err := c.Service.Remove(ctx, actorId, req.OrganizationId, req.UserId); err != nil {
+		return nil, err
+	}
+	return &adminpb.RemoveOrganizationUserResponse{Success: true}, nil
+}
+
+func ConvertOrganizationUserToProto(m entity.OrganizationUser) *adminpb.OrganizationUser {
+	return &adminpb.OrganizationUser{
+		Id:             m.ID,
+		OrganizationId: m.OrganizationID,
+		UserId:         m.UserID,
+		Email:          m.User.Email,
+		Name:           m.User.Name,
+		Role:           m.Role,
+		CreatedAt:      m.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      m.UpdatedAt.Format(time.RFC3339),
+	}
+}
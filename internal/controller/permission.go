@@ -2,14 +2,12 @@ package controller
 
 import (
 	"context"
-	"errors"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-	"gorm.io/gorm"
 
 	adminpb "persacc/api/v1/admin"
 	"persacc/internal/entity"
+	"persacc/internal/fieldmask"
+	"persacc/internal/listquery"
+	"persacc/internal/pagination"
 	"persacc/internal/service"
 )
 
@@ -28,7 +26,7 @@ func (c *PermissionController) Create(ctx context.Context, req *adminpb.CreatePe
 	}
 
 	if err := c.Service.Create(ctx, &permission); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create permission: %v", err)
+		return nil, err
 	}
 
 	return &adminpb.CreatePermissionResponse{
@@ -39,10 +37,7 @@ func (c *PermissionController) Create(ctx context.Context, req *adminpb.CreatePe
 func (c *PermissionController) Get(ctx context.Context, req *adminpb.GetPermissionRequest) (*adminpb.GetPermissionResponse, error) {
 	permission, err := c.Service.Get(ctx, req.Id)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "permission not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get permission: %v", err)
+		return nil, err
 	}
 
 	return &adminpb.GetPermissionResponse{
@@ -51,23 +46,25 @@ func (c *PermissionController) Get(ctx context.Context, req *adminpb.GetPermissi
 }
 
 func (c *PermissionController) Update(ctx context.Context, req *adminpb.UpdatePermissionRequest) (*adminpb.UpdatePermissionResponse, error) {
+	mask, err := fieldmask.New(req.UpdateMask, "name", "description")
+	if err != nil {
+		return nil, err
+	}
 	permission, err := c.Service.Get(ctx, req.Id)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "permission not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to find permission: %v", err)
+		return nil, err
 	}
+	before := *permission
 
-	if req.Name != "" {
+	if mask.Updates("name", req.Name != "") {
 		permission.Name = req.Name
 	}
-	if req.Description != "" {
+	if mask.Updates("description", req.Description != "") {
 		permission.Description = req.Description
 	}
 
-	if err := c.Service.Update(ctx, permission); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update permission: %v", err)
+	if err := c.Service.Update(ctx, &before, permission, req.Version); err != nil {
+		return nil, err
 	}
 
 	return &adminpb.UpdatePermissionResponse{
@@ -76,38 +73,31 @@ func (c *PermissionController) Update(ctx context.Context, req *adminpb.UpdatePe
 }
 
 func (c *PermissionController) Delete(ctx context.Context, req *adminpb.DeletePermissionRequest) (*adminpb.DeletePermissionResponse, error) {
-	if err := c.Service.Delete(ctx, req.Id); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete permission: %v", err)
+	if err := c.Service.Delete(ctx, req.Id, req.Version); err != nil {
+		return nil, err
 	}
 	return &adminpb.DeletePermissionResponse{Success: true}, nil
 }
 
 func (c *PermissionController) List(ctx context.Context, req *adminpb.ListPermissionsRequest) (*adminpb.ListPermissionsResponse, error) {
-	limit := int(req.Limit)
-	if limit <= 0 {
-		limit = 10
-	}
-	page := int(req.Page)
-	if page <= 0 {
-		page = 1
-	}
-	offset := (page - 1) * limit
+	page := pagination.NewRequest(req.Page, req.Limit, req.PageToken)
 
-	permissions, total, err := c.Service.List(ctx, limit, offset)
+	result, err := c.Service.List(ctx, page, listquery.Request{Filter: req.Filter, OrderBy: req.OrderBy})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list permissions: %v", err)
+		return nil, err
 	}
 
 	var protoPermissions []*adminpb.Permission
-	for _, p := range permissions {
+	for _, p := range result.Items {
 		protoPermissions = append(protoPermissions, ConvertPermissionToProto(p))
 	}
 
 	return &adminpb.ListPermissionsResponse{
-		Permissions: protoPermissions,
-		Total:       int32(total),
-		Page:        int32(page),
-		Limit:       int32(limit),
+		Permissions:   protoPermissions,
+		Total:         int32(result.Total),
+		Page:          int32(page.Page),
+		Limit:         int32(page.Limit),
+		NextPageToken: result.NextPageToken,
 	}, nil
 }
 
@@ -116,5 +106,6 @@ func ConvertPermissionToProto(p entity.Permission) *adminpb.Permission {
 		Id:          p.ID,
 		Name:        p.Name,
 		Description: p.Description,
+		Version:     p.Version,
 	}
 }
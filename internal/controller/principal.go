@@ -0,0 +1,19 @@
+package controller
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"persacc/internal/principal"
+)
+
+// principalError reports a caller the interceptors did not resolve for the
+// method, which happens only if the method's access rule is misconfigured.
+func principalError(err error) error {
+	if errors.Is(err, principal.ErrNoOrganization) {
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	return status.Errorf(codes.Unauthenticated, "%v", err)
+}
@@ -2,15 +2,14 @@ package controller
 
 import (
 	"context"
-	"errors"
 	"time"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-	"gorm.io/gorm"
-
 	adminpb "persacc/api/v1/admin"
 	"persacc/internal/entity"
+	"persacc/internal/fieldmask"
+	"persacc/internal/listquery"
+	"persacc/internal/pagination"
+	"persacc/internal/principal"
 	"persacc/internal/service"
 )
 
@@ -23,7 +22,14 @@ func NewProductController(service *service.ProductService) *ProductController {
 }
 
 func (c *ProductController) Create(ctx context.Context, req *adminpb.CreateProductRequest) (*adminpb.CreateProductResponse, error) {
-	orgId := ctx.Value("organization_id").(int64)
+	orgId, err := principal.OrganizationID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
+
+	if req.GenerateSku && req.Sku != "" {
+		return nil, service.NewValidationError("sku", "must be empty when generate_sku is set")
+	}
 
 	product := entity.Product{
 		OrganizationID: orgId,
@@ -55,8 +61,8 @@ func (c *ProductController) Create(ctx context.Context, req *adminpb.CreateProdu
 		product.VendorProductCode = &req.VendorProductCode
 	}
 
-	if err := c.Service.Create(ctx, &product); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create product: %v", err)
+	if err := c.Service.Create(ctx, &product, req.GenerateSku); err != nil {
+		return nil, err
 	}
 
 	return &adminpb.CreateProductResponse{
@@ -65,13 +71,13 @@ func (c *ProductController) Create(ctx context.Context, req *adminpb.CreateProdu
 }
 
 func (c *ProductController) Get(ctx context.Context, req *adminpb.GetProductRequest) (*adminpb.GetProductResponse, error) {
-	orgId := ctx.Value("organization_id").(int64)
+	orgId, err := principal.OrganizationID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
 	product, err := c.Service.Get(ctx, req.Id, orgId)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "product not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get product: %v", err)
+		return nil, err
 	}
 
 	return &adminpb.GetProductResponse{
@@ -80,51 +86,55 @@ func (c *ProductController) Get(ctx context.Context, req *adminpb.GetProductRequ
 }
 
 func (c *ProductController) Update(ctx context.Context, req *adminpb.UpdateProductRequest) (*adminpb.UpdateProductResponse, error) {
-	orgId := ctx.Value("organization_id").(int64)
+	mask, err := fieldmask.New(req.UpdateMask, "sku", "name", "description", "additional_details", "category_id", "vendor_id", "vendor_product_code")
+	if err != nil {
+		return nil, err
+	}
+	orgId, err := principal.OrganizationID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
 	product, err := c.Service.Get(ctx, req.Id, orgId)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "product not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to find product: %v", err)
+		return nil, err
 	}
+	before := *product
 
-	if req.Name != "" {
+	if mask.Updates("name", req.Name != "") {
 		product.Name = req.Name
 	}
-	if req.Sku != "" {
+	if mask.Updates("sku", req.Sku != "") {
 		product.SKU = req.Sku
 	}
-	if req.Description != "" {
-		desc := req.Description
-		product.Description = &desc
+	if mask.Updates("description", req.Description != "") {
+		product.Description = optionalString(req.Description)
 	}
 
-	if len(req.AdditionalDetails) > 0 {
+	if mask.Updates("additional_details", len(req.AdditionalDetails) > 0) {
 		if product.ProductDetails == nil {
-			product.ProductDetails = &entity.ProductDetail{
-				AdditionalDetails: make(map[string]interface{}),
-			}
-		} else if product.ProductDetails.AdditionalDetails == nil {
-			product.ProductDetails.AdditionalDetails = make(map[string]interface{})
+			product.ProductDetails = &entity.ProductDetail{}
+		}
+		// Without a mask the details are merged; a masked update replaces them
+		if !mask.Empty() || product.ProductDetails.AdditionalDetails == nil {
+			product.ProductDetails.AdditionalDetails = make(map[string]interface{}, len(req.AdditionalDetails))
 		}
 		for k, v := range req.AdditionalDetails {
 			product.ProductDetails.AdditionalDetails[k] = v
 		}
 	}
 
-	if req.CategoryId != 0 {
-		product.CategoryID = &req.CategoryId
+	if mask.Updates("category_id", req.CategoryId != 0) {
+		product.CategoryID = optionalID(req.CategoryId)
 	}
-	if req.VendorId != 0 {
-		product.VendorID = &req.VendorId
+	if mask.Updates("vendor_id", req.VendorId != 0) {
+		product.VendorID = optionalID(req.VendorId)
 	}
-	if req.VendorProductCode != "" {
-		product.VendorProductCode = &req.VendorProductCode
+	if mask.Updates("vendor_product_code", req.VendorProductCode != "") {
+		product.VendorProductCode = optionalString(req.VendorProductCode)
 	}
 
-	if err := c.Service.Update(ctx, product, orgId); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update product: %v", err)
+	if err := c.Service.Update(ctx, &before, product, req.Version, orgId); err != nil {
+		return nil, err
 	}
 
 	return &adminpb.UpdateProductResponse{
@@ -133,25 +143,23 @@ func (c *ProductController) Update(ctx context.Context, req *adminpb.UpdateProdu
 }
 
 func (c *ProductController) Delete(ctx context.Context, req *adminpb.DeleteProductRequest) (*adminpb.DeleteProductResponse, error) {
-	orgId := ctx.Value("organization_id").(int64)
-	if err := c.Service.Delete(ctx, req.Id, orgId); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete product: %v", err)
+	orgId, err := principal.OrganizationID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
+	if err := c.Service.Delete(ctx, req.Id, orgId, req.Version); err != nil {
+		return nil, err
 	}
 	return &adminpb.DeleteProductResponse{Success: true}, nil
 }
 
 func (c *ProductController) List(ctx context.Context, req *adminpb.ListProductsRequest) (*adminpb.ListProductsResponse, error) {
-	limit := int(req.Limit)
-	if limit <= 0 {
-		limit = 10
-	}
-	page := int(req.Page)
-	if page <= 0 {
-		page = 1
-	}
-	offset := (page - 1) * limit
+	page := pagination.NewRequest(req.Page, req.Limit, req.PageToken)
 
-	orgId := ctx.Value("organization_id").(int64)
+	orgId, err := principal.OrganizationID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
 
 	filters := make(map[string]string)
 	if req.Name != "" {
@@ -164,21 +172,22 @@ func (c *ProductController) List(ctx context.Context, req *adminpb.ListProductsR
 		filters["description"] = req.Description
 	}
 
-	products, total, err := c.Service.List(ctx, limit, offset, orgId, filters)
+	result, err := c.Service.List(ctx, page, listquery.Request{Filter: req.Filter, OrderBy: req.OrderBy}, orgId, filters)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list products: %v", err)
+		return nil, err
 	}
 
 	var protoProducts []*adminpb.Product
-	for _, p := range products {
+	for _, p := range result.Items {
 		protoProducts = append(protoProducts, ConvertProductToProto(p))
 	}
 
 	return &adminpb.ListProductsResponse{
-		Products: protoProducts,
-		Total:    int32(total),
-		Page:     int32(page),
-		Limit:    int32(limit),
+		Products:      protoProducts,
+		Total:         int32(result.Total),
+		Page:          int32(page.Page),
+		Limit:         int32(page.Limit),
+		NextPageToken: result.NextPageToken,
 	}, nil
 }
 
@@ -222,5 +231,6 @@ func ConvertProductToProto(p entity.Product) *adminpb.Product {
 		CategoryId:        categoryId,
 		VendorId:          vendorId,
 		VendorProductCode: vendorProductCode,
+		Version:           p.Version,
 	}
 }
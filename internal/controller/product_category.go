@@ -2,15 +2,14 @@ package controller
 
 import (
 	"context"
-	"errors"
 	"time"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-	"gorm.io/gorm"
-
 	adminpb "persacc/api/v1/admin"
 	"persacc/internal/entity"
+	"persacc/internal/fieldmask"
+	"persacc/internal/listquery"
+	"persacc/internal/pagination"
+	"persacc/internal/principal"
 	"persacc/internal/service"
 )
 
@@ -23,7 +22,10 @@ func NewProductCategoryController(service *service.ProductCategoryService) *Prod
 }
 
 func (c *ProductCategoryController) Create(ctx context.Context, req *adminpb.CreateProductCategoryRequest) (*adminpb.CreateProductCategoryResponse, error) {
-	orgId := ctx.Value("organization_id").(int64)
+	orgId, err := principal.OrganizationID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
 
 	category := entity.ProductCategory{
 		OrganizationID: orgId,
@@ -36,7 +38,7 @@ func (c *ProductCategoryController) Create(ctx context.Context, req *adminpb.Cre
 	}
 
 	if err := c.Service.Create(ctx, &category); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create product category: %v", err)
+		return nil, err
 	}
 
 	return &adminpb.CreateProductCategoryResponse{
@@ -45,13 +47,13 @@ func (c *ProductCategoryController) Create(ctx context.Context, req *adminpb.Cre
 }
 
 func (c *ProductCategoryController) Get(ctx context.Context, req *adminpb.GetProductCategoryRequest) (*adminpb.GetProductCategoryResponse, error) {
-	orgId := ctx.Value("organization_id").(int64)
+	orgId, err := principal.OrganizationID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
 	category, err := c.Service.Get(ctx, req.Id, orgId)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "product category not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get product category: %v", err)
+		return nil, err
 	}
 
 	return &adminpb.GetProductCategoryResponse{
@@ -60,25 +62,29 @@ func (c *ProductCategoryController) Get(ctx context.Context, req *adminpb.GetPro
 }
 
 func (c *ProductCategoryController) Update(ctx context.Context, req *adminpb.UpdateProductCategoryRequest) (*adminpb.UpdateProductCategoryResponse, error) {
-	orgId := ctx.Value("organization_id").(int64)
+	mask, err := fieldmask.New(req.UpdateMask, "name", "description")
+	if err != nil {
+		return nil, err
+	}
+	orgId, err := principal.OrganizationID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
 	category, err := c.Service.Get(ctx, req.Id, orgId)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "product category not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to find product category: %v", err)
+		return nil, err
 	}
+	before := *category
 
-	if req.Name != "" {
+	if mask.Updates("name", req.Name != "") {
 		category.Name = req.Name
 	}
-	if req.Description != "" {
-		desc := req.Description
-		category.Description = &desc
+	if mask.Updates("description", req.Description != "") {
+		category.Description = optionalString(req.Description)
 	}
 
-	if err := c.Service.Update(ctx, category, orgId); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update product category: %v", err)
+	if err := c.Service.Update(ctx, &before, category, req.Version, orgId); err != nil {
+		return nil, err
 	}
 
 	return &adminpb.UpdateProductCategoryResponse{
@@ -87,46 +93,45 @@ func (c *ProductCategoryController) Update(ctx context.Context, req *adminpb.Upd
 }
 
 func (c *ProductCategoryController) Delete(ctx context.Context, req *adminpb.DeleteProductCategoryRequest) (*adminpb.DeleteProductCategoryResponse, error) {
-	orgId := ctx.Value("organization_id").(int64)
-	if err := c.Service.Delete(ctx, req.Id, orgId); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete product category: %v", err)
+	orgId, err := principal.OrganizationID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
+	if err := c.Service.Delete(ctx, req.Id, orgId, req.Version); err != nil {
+		return nil, err
 	}
 	return &adminpb.DeleteProductCategoryResponse{Success: true}, nil
 }
 
 func (c *ProductCategoryController) List(ctx context.Context, req *adminpb.ListProductCategoriesRequest) (*adminpb.ListProductCategoriesResponse, error) {
-	limit := int(req.Limit)
-	if limit <= 0 {
-		limit = 10
-	}
-	page := int(req.Page)
-	if page <= 0 {
-		page = 1
-	}
-	offset := (page - 1) * limit
+	page := pagination.NewRequest(req.Page, req.Limit, req.PageToken)
 
-	orgId := ctx.Value("organization_id").(int64)
+	orgId, err := principal.OrganizationID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
 
 	filters := make(map[string]string)
 	if req.Name != "" {
 		filters["name"] = req.Name
 	}
 
-	categories, total, err := c.Service.List(ctx, limit, offset, orgId, filters)
+	result, err := c.Service.List(ctx, page, listquery.Request{Filter: req.Filter, OrderBy: req.OrderBy}, orgId, filters)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list product categories: %v", err)
+		return nil, err
 	}
 
 	var protoCategories []*adminpb.ProductCategory
-	for _, cat := range categories {
+	for _, cat := range result.Items {
 		protoCategories = append(protoCategories, ConvertProductCategoryToProto(cat))
 	}
 
 	return &adminpb.ListProductCategoriesResponse{
-		Categories: protoCategories,
-		Total:      int32(total),
-		Page:       int32(page),
-		Limit:      int32(limit),
+		Categories:    protoCategories,
+		Total:         int32(result.Total),
+		Page:          int32(page.Page),
+		Limit:         int32(page.Limit),
+		NextPageToken: result.NextPageToken,
 	}, nil
 }
 
@@ -143,5 +148,6 @@ func ConvertProductCategoryToProto(cat entity.ProductCategory) *adminpb.ProductC
 		Description:    description,
 		CreatedAt:      cat.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:      cat.UpdatedAt.Format(time.RFC3339),
+		Version:        cat.Version,
 	}
 }
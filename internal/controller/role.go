@@ -2,14 +2,12 @@ package controller
 
 import (
 	"context"
-	"errors"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-	"gorm.io/gorm"
 
 	adminpb "persacc/api/v1/admin"
 	"persacc/internal/entity"
+	"persacc/internal/fieldmask"
+	"persacc/internal/listquery"
+	"persacc/internal/pagination"
 	"persacc/internal/service"
 )
 
@@ -27,7 +25,7 @@ func (c *RoleController) Create(ctx context.Context, req *adminpb.CreateRoleRequ
 	}
 
 	if err := c.Service.Create(ctx, &role, req.PermissionIds); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create role: %v", err)
+		return nil, err
 	}
 
 	return &adminpb.CreateRoleResponse{
@@ -38,10 +36,7 @@ func (c *RoleController) Create(ctx context.Context, req *adminpb.CreateRoleRequ
 func (c *RoleController) Get(ctx context.Context, req *adminpb.GetRoleRequest) (*adminpb.GetRoleResponse, error) {
 	role, err := c.Service.Get(ctx, req.Id)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "role not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get role: %v", err)
+		return nil, err
 	}
 
 	return &adminpb.GetRoleResponse{
@@ -50,21 +45,23 @@ func (c *RoleController) Get(ctx context.Context, req *adminpb.GetRoleRequest) (
 }
 
 func (c *RoleController) Update(ctx context.Context, req *adminpb.UpdateRoleRequest) (*adminpb.UpdateRoleResponse, error) {
+	mask, err := fieldmask.New(req.UpdateMask, "name", "permission_ids")
+	if err != nil {
+		return nil, err
+	}
 	role, err := c.Service.Get(ctx, req.Id)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "role not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to find role: %v", err)
+		return nil, err
 	}
+	before := *role
 
-	if req.Name != "" {
+	if mask.Updates("name", req.Name != "") {
 		role.Name = req.Name
 	}
 
-	updatePerms := req.PermissionIds != nil
-	if err := c.Service.Update(ctx, role, req.PermissionIds, updatePerms); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update role: %v", err)
+	updatePerms := mask.Updates("permission_ids", req.PermissionIds != nil)
+	if err := c.Service.Update(ctx, &before, role, req.Version, req.PermissionIds, updatePerms); err != nil {
+		return nil, err
 	}
 
 	return &adminpb.UpdateRoleResponse{
@@ -73,38 +70,31 @@ func (c *RoleController) Update(ctx context.Context, req *adminpb.UpdateRoleRequ
 }
 
 func (c *RoleController) Delete(ctx context.Context, req *adminpb.DeleteRoleRequest) (*adminpb.DeleteRoleResponse, error) {
-	if err := c.Service.Delete(ctx, req.Id); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete role: %v", err)
+	if err := c.Service.Delete(ctx, req.Id, req.Version); err != nil {
+		return nil, err
 	}
 	return &adminpb.DeleteRoleResponse{Success: true}, nil
 }
 
 func (c *RoleController) List(ctx context.Context, req *adminpb.ListRolesRequest) (*adminpb.ListRolesResponse, error) {
-	limit := int(req.Limit)
-	if limit <= 0 {
-		limit = 10
-	}
-	page := int(req.Page)
-	if page <= 0 {
-		page = 1
-	}
-	offset := (page - 1) * limit
+	page := pagination.NewRequest(req.Page, req.Limit, req.PageToken)
 
-	roles, total, err := c.Service.List(ctx, limit, offset)
+	result, err := c.Service.List(ctx, page, listquery.Request{Filter: req.Filter, OrderBy: req.OrderBy})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list roles: %v", err)
+		return nil, err
 	}
 
 	var protoRoles []*adminpb.Role
-	for _, r := range roles {
+	for _, r := range result.Items {
 		protoRoles = append(protoRoles, ConvertRoleToProto(r))
 	}
 
 	return &adminpb.ListRolesResponse{
-		Roles: protoRoles,
-		Total: int32(total),
-		Page:  int32(page),
-		Limit: int32(limit),
+		Roles:         protoRoles,
+		Total:         int32(result.Total),
+		Page:          int32(page.Page),
+		Limit:         int32(page.Limit),
+		NextPageToken: result.NextPageToken,
 	}, nil
 }
 
@@ -118,5 +108,6 @@ func ConvertRoleToProto(r entity.Role) *adminpb.Role {
 		Id:          r.ID,
 		Name:        r.Name,
 		Permissions: protoPerms,
+		Version:     r.Version,
 	}
 }
@@ -2,15 +2,14 @@ package controller
 
 import (
 	"context"
-	"errors"
 	"time"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-	"gorm.io/gorm"
-
 	adminpb "persacc/api/v1/admin"
 	"persacc/internal/entity"
+	"persacc/internal/fieldmask"
+	"persacc/internal/listquery"
+	"persacc/internal/pagination"
+	"persacc/internal/principal"
 	"persacc/internal/service"
 )
 
@@ -23,7 +22,10 @@ func NewSupplierController(service *service.SupplierService) *SupplierController
 }
 
 func (c *SupplierController) Create(ctx context.Context, req *adminpb.CreateSupplierRequest) (*adminpb.CreateSupplierResponse, error) {
-	orgId := ctx.Value("organization_id").(int64)
+	orgId, err := principal.OrganizationID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
 
 	supplier := entity.Supplier{
 		Name:           req.Name,
@@ -41,7 +43,7 @@ func (c *SupplierController) Create(ctx context.Context, req *adminpb.CreateSupp
 	}
 
 	if err := c.Service.Create(ctx, &supplier); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create supplier: %v", err)
+		return nil, err
 	}
 
 	return &adminpb.CreateSupplierResponse{
@@ -50,13 +52,13 @@ func (c *SupplierController) Create(ctx context.Context, req *adminpb.CreateSupp
 }
 
 func (c *SupplierController) Get(ctx context.Context, req *adminpb.GetSupplierRequest) (*adminpb.GetSupplierResponse, error) {
-	orgId := ctx.Value("organization_id").(int64)
+	orgId, err := principal.OrganizationID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
 	supplier, err := c.Service.Get(ctx, req.Id, orgId)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "supplier not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get supplier: %v", err)
+		return nil, err
 	}
 
 	return &adminpb.GetSupplierResponse{
@@ -65,30 +67,35 @@ func (c *SupplierController) Get(ctx context.Context, req *adminpb.GetSupplierRe
 }
 
 func (c *SupplierController) Update(ctx context.Context, req *adminpb.UpdateSupplierRequest) (*adminpb.UpdateSupplierResponse, error) {
-	orgId := ctx.Value("organization_id").(int64)
+	mask, err := fieldmask.New(req.UpdateMask, "name", "domain", "phone", "description")
+	if err != nil {
+		return nil, err
+	}
+	orgId, err := principal.OrganizationID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
 	supplier, err := c.Service.Get(ctx, req.Id, orgId)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "supplier not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to find supplier: %v", err)
+		return nil, err
 	}
+	before := *supplier
 
-	if req.Name != "" {
+	if mask.Updates("name", req.Name != "") {
 		supplier.Name = req.Name
 	}
-	if req.Domain != "" {
-		supplier.Domain = &req.Domain
+	if mask.Updates("domain", req.Domain != "") {
+		supplier.Domain = optionalString(req.Domain)
 	}
-	if req.Phone != "" {
-		supplier.Phone = &req.Phone
+	if mask.Updates("phone", req.Phone != "") {
+		supplier.Phone = optionalString(req.Phone)
 	}
-	if req.Description != "" {
-		supplier.Description = &req.Description
+	if mask.Updates("description", req.Description != "") {
+		supplier.Description = optionalString(req.Description)
 	}
 
-	if err := c.Service.Update(ctx, supplier, orgId); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update supplier: %v", err)
+	if err := c.Service.Update(ctx, &before, supplier, req.Version, orgId); err != nil {
+		return nil, err
 	}
 
 	return &adminpb.UpdateSupplierResponse{
@@ -97,46 +104,45 @@ func (c *SupplierController) Update(ctx context.Context, req *adminpb.UpdateSupp
 }
 
 func (c *SupplierController) Delete(ctx context.Context, req *adminpb.DeleteSupplierRequest) (*adminpb.DeleteSupplierResponse, error) {
-	orgId := ctx.Value("organization_id").(int64)
-	if err := c.Service.Delete(ctx, req.Id, orgId); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete supplier: %v", err)
+	orgId, err := principal.OrganizationID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
+	if err := c.Service.Delete(ctx, req.Id, orgId, req.Version); err != nil {
+		return nil, err
 	}
 	return &adminpb.DeleteSupplierResponse{Success: true}, nil
 }
 
 func (c *SupplierController) List(ctx context.Context, req *adminpb.ListSuppliersRequest) (*adminpb.ListSuppliersResponse, error) {
-	limit := int(req.Limit)
-	if limit <= 0 {
-		limit = 10
-	}
-	page := int(req.Page)
-	if page <= 0 {
-		page = 1
-	}
-	offset := (page - 1) * limit
+	page := pagination.NewRequest(req.Page, req.Limit, req.PageToken)
 
-	orgId := ctx.Value("organization_id").(int64)
+	orgId, err := principal.OrganizationID(ctx)
+	if err != nil {
+		return nil, principalError(err)
+	}
 
 	filters := make(map[string]string)
 	if req.Name != "" {
 		filters["name"] = req.Name
 	}
 
-	suppliers, total, err := c.Service.List(ctx, limit, offset, orgId, filters)
+	result, err := c.Service.List(ctx, page, listquery.Request{Filter: req.Filter, OrderBy: req.OrderBy}, orgId, filters)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list suppliers: %v", err)
+		return nil, err
 	}
 
 	var protoSuppliers []*adminpb.Supplier
-	for _, s := range suppliers {
+	for _, s := range result.Items {
 		protoSuppliers = append(protoSuppliers, ConvertSupplierToProto(s))
 	}
 
 	return &adminpb.ListSuppliersResponse{
-		Suppliers: protoSuppliers,
-		Total:     int32(total),
-		Page:      int32(page),
-		Limit:     int32(limit),
+		Suppliers:     protoSuppliers,
+		Total:         int32(result.Total),
+		Page:          int32(page.Page),
+		Limit:         int32(page.Limit),
+		NextPageToken: result.NextPageToken,
 	}, nil
 }
 
@@ -160,5 +166,6 @@ func ConvertSupplierToProto(s entity.Supplier) *adminpb.Supplier {
 		Description: description,
 		CreatedAt:   s.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:   s.UpdatedAt.Format(time.RFC3339),
+		Version:     s.Version,
 	}
 }
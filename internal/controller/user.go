@@ -2,14 +2,16 @@ package controller
 
 import (
 	"context"
-	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"gorm.io/gorm"
 
 	adminpb "persacc/api/v1/admin"
 	"persacc/internal/entity"
+	"persacc/internal/fieldmask"
+	"persacc/internal/listquery"
+	"persacc/internal/pagination"
+	"persacc/internal/principal"
 	"persacc/internal/service"
 )
 
@@ -22,22 +24,20 @@ func NewUserController(service *service.UserService) *UserController {
 }
 
 func (c *UserController) Register(ctx context.Context, req *adminpb.RegisterRequest) (*adminpb.RegisterResponse, error) {
-	email, ok := ctx.Value("email").(string)
-	if !ok || email == "" {
-		return nil, status.Errorf(codes.Internal, "email not found in context")
+	claims, err := principal.TokenClaims(ctx)
+	if err != nil {
+		return nil, principalError(err)
 	}
-
-	name, ok := ctx.Value("name").(string)
-	if !ok || name == "" {
-		return nil, status.Errorf(codes.Internal, "name not found in context")
+	if claims.Email == "" {
+		return nil, status.Errorf(codes.Internal, "email not found in token")
+	}
+	if claims.Name == "" {
+		return nil, status.Errorf(codes.Internal, "name not found in token")
 	}
 
-	user, err := c.Service.Register(ctx, email, name)
+	user, err := c.Service.Register(ctx, claims.Email, claims.Name)
 	if err != nil {
-		if err.Error() == "user with this email already exists" {
-			return nil, status.Errorf(codes.AlreadyExists, "%v", err.Error())
-		}
-		return nil, status.Errorf(codes.Internal, "failed to register user: %v", err)
+		return nil, err
 	}
 
 	return &adminpb.RegisterResponse{
@@ -53,7 +53,7 @@ func (c *UserController) Create(ctx context.Context, req *adminpb.CreateUserRequ
 	}
 
 	if err := c.Service.Create(ctx, &user); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
+		return nil, err
 	}
 
 	return &adminpb.CreateUserResponse{
@@ -64,10 +64,7 @@ func (c *UserController) Create(ctx context.Context, req *adminpb.CreateUserRequ
 func (c *UserController) Get(ctx context.Context, req *adminpb.GetUserRequest) (*adminpb.GetUserResponse, error) {
 	user, err := c.Service.Get(ctx, req.Id)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "user not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		return nil, err
 	}
 
 	return &adminpb.GetUserResponse{
@@ -76,26 +73,28 @@ func (c *UserController) Get(ctx context.Context, req *adminpb.GetUserRequest) (
 }
 
 func (c *UserController) Update(ctx context.Context, req *adminpb.UpdateUserRequest) (*adminpb.UpdateUserResponse, error) {
+	mask, err := fieldmask.New(req.UpdateMask, "name", "email", "role_id")
+	if err != nil {
+		return nil, err
+	}
 	user, err := c.Service.Get(ctx, req.Id)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "user not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to find user: %v", err)
+		return nil, err
 	}
+	before := *user
 
-	if req.Name != "" {
+	if mask.Updates("name", req.Name != "") {
 		user.Name = req.Name
 	}
-	if req.Email != "" {
+	if mask.Updates("email", req.Email != "") {
 		user.Email = req.Email
 	}
-	if req.RoleId != 0 {
+	if mask.Updates("role_id", req.RoleId != 0) {
 		user.RoleID = req.RoleId
 	}
 
-	if err := c.Service.Update(ctx, user); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
+	if err := c.Service.Update(ctx, &before, user, req.Version); err != nil {
+		return nil, err
 	}
 
 	return &adminpb.UpdateUserResponse{
@@ -104,46 +103,40 @@ func (c *UserController) Update(ctx context.Context, req *adminpb.UpdateUserRequ
 }
 
 func (c *UserController) Delete(ctx context.Context, req *adminpb.DeleteUserRequest) (*adminpb.DeleteUserResponse, error) {
-	if err := c.Service.Delete(ctx, req.Id); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete user: %v", err)
+	if err := c.Service.Delete(ctx, req.Id, req.Version); err != nil {
+		return nil, err
 	}
 	return &adminpb.DeleteUserResponse{Success: true}, nil
 }
 
 func (c *UserController) List(ctx context.Context, req *adminpb.ListUsersRequest) (*adminpb.ListUsersResponse, error) {
-	limit := int(req.Limit)
-	if limit <= 0 {
-		limit = 10
-	}
-	page := int(req.Page)
-	if page <= 0 {
-		page = 1
-	}
-	offset := (page - 1) * limit
+	page := pagination.NewRequest(req.Page, req.Limit, req.PageToken)
 
-	users, total, err := c.Service.List(ctx, limit, offset)
+	result, err := c.Service.List(ctx, page, listquery.Request{Filter: req.Filter, OrderBy: req.OrderBy})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
+		return nil, err
 	}
 
 	var protoUsers []*adminpb.User
-	for _, u := range users {
+	for _, u := range result.Items {
 		protoUsers = append(protoUsers, ConvertUserToProto(u))
 	}
 
 	return &adminpb.ListUsersResponse{
-		Users: protoUsers,
-		Total: int32(total),
-		Page:  int32(page),
-		Limit: int32(limit),
+		Users:         protoUsers,
+		Total:         int32(result.Total),
+		Page:          int32(page.Page),
+		Limit:         int32(page.Limit),
+		NextPageToken: result.NextPageToken,
 	}, nil
 }
 
 func ConvertUserToProto(u entity.User) *adminpb.User {
 	return &adminpb.User{
-		Id:     u.ID,
-		Name:   u.Name,
-		Email:  u.Email,
-		RoleId: u.RoleID,
+		Id:      u.ID,
+		Name:    u.Name,
+		Email:   u.Email,
+		RoleId:  u.RoleID,
+		Version: u.Version,
 	}
 }
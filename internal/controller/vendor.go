@@ -2,15 +2,13 @@ package controller
 
 import (
 	"context"
-	"errors"
 	"time"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-	"gorm.io/gorm"
-
 	adminpb "persacc/api/v1/admin"
 	"persacc/internal/entity"
+	"persacc/internal/fieldmask"
+	"persacc/internal/listquery"
+	"persacc/internal/pagination"
 	"persacc/internal/service"
 )
 
@@ -35,7 +33,7 @@ func (c *VendorController) Create(ctx context.Context, req *adminpb.CreateVendor
 	}
 
 	if err := c.Service.Create(ctx, &vendor); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create vendor: %v", err)
+		return nil, err
 	}
 
 	return &adminpb.CreateVendorResponse{
@@ -46,10 +44,7 @@ func (c *VendorController) Create(ctx context.Context, req *adminpb.CreateVendor
 func (c *VendorController) Get(ctx context.Context, req *adminpb.GetVendorRequest) (*adminpb.GetVendorResponse, error) {
 	vendor, err := c.Service.Get(ctx, req.Id)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "vendor not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get vendor: %v", err)
+		return nil, err
 	}
 
 	return &adminpb.GetVendorResponse{
@@ -58,26 +53,28 @@ func (c *VendorController) Get(ctx context.Context, req *adminpb.GetVendorReques
 }
 
 func (c *VendorController) Update(ctx context.Context, req *adminpb.UpdateVendorRequest) (*adminpb.UpdateVendorResponse, error) {
+	mask, err := fieldmask.New(req.UpdateMask, "name", "domain", "description")
+	if err != nil {
+		return nil, err
+	}
 	vendor, err := c.Service.Get(ctx, req.Id)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "vendor not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to find vendor: %v", err)
+		return nil, err
 	}
+	before := *vendor
 
-	if req.Name != "" {
+	if mask.Updates("name", req.Name != "") {
 		vendor.Name = req.Name
 	}
-	if req.Domain != "" {
-		vendor.Domain = &req.Domain
+	if mask.Updates("domain", req.Domain != "") {
+		vendor.Domain = optionalString(req.Domain)
 	}
-	if req.Description != "" {
-		vendor.Description = &req.Description
+	if mask.Updates("description", req.Description != "") {
+		vendor.Description = optionalString(req.Description)
 	}
 
-	if err := c.Service.Update(ctx, vendor); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update vendor: %v", err)
+	if err := c.Service.Update(ctx, &before, vendor, req.Version); err != nil {
+		return nil, err
 	}
 
 	return &adminpb.UpdateVendorResponse{
@@ -86,43 +83,36 @@ func (c *VendorController) Update(ctx context.Context, req *adminpb.UpdateVendor
 }
 
 func (c *VendorController) Delete(ctx context.Context, req *adminpb.DeleteVendorRequest) (*adminpb.DeleteVendorResponse, error) {
-	if err := c.Service.Delete(ctx, req.Id); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete vendor: %v", err)
+	if err := c.Service.Delete(ctx, req.Id, req.Version); err != nil {
+		return nil, err
 	}
 	return &adminpb.DeleteVendorResponse{Success: true}, nil
 }
 
 func (c *VendorController) List(ctx context.Context, req *adminpb.ListVendorsRequest) (*adminpb.ListVendorsResponse, error) {
-	limit := int(req.Limit)
-	if limit <= 0 {
-		limit = 10
-	}
-	page := int(req.Page)
-	if page <= 0 {
-		page = 1
-	}
-	offset := (page - 1) * limit
+	page := pagination.NewRequest(req.Page, req.Limit, req.PageToken)
 
 	filters := make(map[string]string)
 	if req.Name != "" {
 		filters["name"] = req.Name
 	}
 
-	vendors, total, err := c.Service.List(ctx, limit, offset, filters)
+	result, err := c.Service.List(ctx, page, listquery.Request{Filter: req.Filter, OrderBy: req.OrderBy}, filters)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list vendors: %v", err)
+		return nil, err
 	}
 
 	var protoVendors []*adminpb.Vendor
-	for _, v := range vendors {
+	for _, v := range result.Items {
 		protoVendors = append(protoVendors, ConvertVendorToProto(v))
 	}
 
 	return &adminpb.ListVendorsResponse{
-		Vendors: protoVendors,
-		Total:   int32(total),
-		Page:    int32(page),
-		Limit:   int32(limit),
+		Vendors:       protoVendors,
+		Total:         int32(result.Total),
+		Page:          int32(page.Page),
+		Limit:         int32(page.Limit),
+		NextPageToken: result.NextPageToken,
 	}, nil
 }
 
@@ -142,5 +132,6 @@ func ConvertVendorToProto(v entity.Vendor) *adminpb.Vendor {
 		Description: description,
 		CreatedAt:   v.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:   v.UpdatedAt.Format(time.RFC3339),
+		Version:     v.Version,
 	}
 }
@@ -0,0 +1,42 @@
+// Package datatest provides a GORM handle for tests that inspect the SQL a
+// query would run without a database.
+package datatest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// Recorder keeps the statements GORM would run.
+type Recorder struct {
+	logger.Interface
+	Statements []string
+}
+
+func (r *Recorder) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	sql, _ := fc()
+	r.Statements = append(r.Statements, sql)
+}
+
+// DryRun opens a Postgres handle that records statements instead of
+// running them. Reads find no rows and writes affect none. Transactions
+// need a connection, so code under test must not open one.
+func DryRun(t testing.TB) (*gorm.DB, *Recorder) {
+	t.Helper()
+	rec := &Recorder{Interface: logger.Discard}
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=127.0.0.1 port=1"}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+		Logger:                 rec,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db, rec
+}
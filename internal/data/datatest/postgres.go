@@ -0,0 +1,79 @@
+package datatest
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"persacc/internal/migrate"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// DSNEnv names the variable holding the DSN of a Postgres database for the
+// tests that need one. They are skipped when it is unset.
+const DSNEnv = "PERSACC_TEST_DSN"
+
+var schemas atomic.Int64
+
+// PostgresDB returns a connection to a new, empty schema of the test
+// database. The schema is dropped when the test ends.
+func PostgresDB(t testing.TB) *sql.DB {
+	t.Helper()
+	dsn := os.Getenv(DSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", DSNEnv)
+	}
+	cfg, err := pgx.ParseConfig(dsn)
+	if err != nil {
+		t.Fatalf("invalid %s: %v", DSNEnv, err)
+	}
+
+	admin := stdlib.OpenDB(*cfg)
+	schema := fmt.Sprintf("test_%d_%d", time.Now().UnixNano(), schemas.Add(1))
+	if _, err := admin.Exec("CREATE SCHEMA " + schema); err != nil {
+		admin.Close()
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if _, err := admin.Exec("DROP SCHEMA " + schema + " CASCADE"); err != nil {
+			t.Errorf("failed to drop schema %s: %v", schema, err)
+		}
+		admin.Close()
+	})
+
+	cfg = cfg.Copy()
+	cfg.RuntimeParams["search_path"] = schema
+	db := stdlib.OpenDB(*cfg)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// Postgres is PostgresDB with every migration applied, opened with GORM.
+func Postgres(t testing.TB) *gorm.DB {
+	t.Helper()
+	sqlDB := PostgresDB(t)
+	m, err := migrate.New(sqlDB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Up(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		DisableAutomaticPing: true,
+		Logger:               logger.Discard,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
@@ -1,36 +1,134 @@
 package data
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
-	"log"
-	"os"
+	"log/slog"
+	"math/rand/v2"
+	"strconv"
+	"time"
 
-	"strings"
+	"persacc/internal/config"
+	"persacc/internal/logging"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
 )
 
-func InitDB() (*gorm.DB, error) {
-	dsn := os.Getenv("DB_DSN")
-	if dsn == "" {
-		// Fallback for local development or raise error
-		dsn = "host=localhost user=postgres password=postgres dbname=persacc port=5452 sslmode=disable"
-		log.Println("DB_DSN not set, using default...")
-	}
+// ReplicaResolver names the dbresolver configuration of the read replica.
+const ReplicaResolver = "replica"
 
-	// Clean DSN: remove leading/trailing whitespace and quotes
-	dsn = strings.TrimSpace(dsn)
-	dsn = strings.Trim(dsn, "\"'")
+// Connection attempts back off exponentially between these bounds.
+const (
+	minRetryWait = 500 * time.Millisecond
+	maxRetryWait = 10 * time.Second
+)
 
-	log.Printf("DB_DSN (quoted): %q", dsn)
-	log.Printf("DB_DSN (bytes): %v", []byte(dsn))
+// InitDB opens the database, waiting up to cfg.ConnectTimeout for it to
+// accept connections, and sizes its connection pool from cfg. With a
+// replica DSN, reads made through Reader may be routed to the replica.
+func InitDB(ctx context.Context, cfg config.DatabaseConfig) (*gorm.DB, error) {
+	slog.Info("connecting to database", "dsn", logging.RedactDSN(cfg.DSN))
+	sqlDB, err := openPool(cfg, cfg.DSN)
+	if err != nil {
+		return nil, err
+	}
+	if err := waitForDB(ctx, sqlDB, cfg.ConnectTimeout); err != nil {
+		sqlDB.Close()
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
 
-	log.Printf("Attempting DSN: %s", dsn)
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	// The primary answered above; skipping GORM's ping also keeps an
+	// unreachable replica from failing the start
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{DisableAutomaticPing: true})
 	if err != nil {
+		sqlDB.Close()
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
+	if cfg.ReplicaDSN != "" {
+		slog.Info("routing reads to replica", "dsn", logging.RedactDSN(cfg.ReplicaDSN))
+		replica, err := openPool(cfg, cfg.ReplicaDSN)
+		if err != nil {
+			sqlDB.Close()
+			return nil, err
+		}
+		resolver := dbresolver.Register(dbresolver.Config{
+			Replicas: []gorm.Dialector{postgres.New(postgres.Config{Conn: replica})},
+		}, ReplicaResolver)
+		if err := db.Use(resolver); err != nil {
+			sqlDB.Close()
+			replica.Close()
+			return nil, fmt.Errorf("failed to configure read replica: %w", err)
+		}
+	}
+
 	return db, nil
 }
+
+// openPool opens a connection pool to dsn with the statement timeout and
+// pool limits of cfg. No connection is made yet.
+func openPool(cfg config.DatabaseConfig, dsn string) (*sql.DB, error) {
+	connConfig, err := pgx.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("invalid database DSN: %w", err)
+	}
+	if cfg.StatementTimeout > 0 {
+		connConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(cfg.StatementTimeout.Milliseconds(), 10)
+	}
+
+	sqlDB := stdlib.OpenDB(*connConfig)
+	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+	return sqlDB, nil
+}
+
+// waitForDB pings sqlDB with exponential backoff until it answers, timeout
+// passes or ctx is done. A zero timeout tries once.
+func waitForDB(ctx context.Context, sqlDB *sql.DB, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	wait := minRetryWait
+	for attempt := 1; ; attempt++ {
+		err := sqlDB.PingContext(ctx)
+		if err == nil {
+			return nil
+		}
+		// Jitter keeps replicas restarted together from retrying in step
+		sleep := wait/2 + rand.N(wait/2)
+		if time.Now().Add(sleep).After(deadline) {
+			return err
+		}
+		slog.Warn("database not ready, retrying", "attempt", attempt, "retry_in", sleep.String(), "error", err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(sleep):
+		}
+		wait = min(wait*2, maxRetryWait)
+	}
+}
+
+type replicaKey struct{}
+
+// AllowReplica marks ctx as tolerating replication lag: reads made through
+// Reader with it may be served by the read replica.
+func AllowReplica(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replicaKey{}, true)
+}
+
+// Reader returns db bound to ctx, reading from the replica when ctx allows
+// it and one is configured.
+func Reader(ctx context.Context, db *gorm.DB) *gorm.DB {
+	db = db.WithContext(ctx)
+	if allowed, _ := ctx.Value(replicaKey{}).(bool); allowed {
+		return db.Clauses(dbresolver.Use(ReplicaResolver))
+	}
+	return db
+}
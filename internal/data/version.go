@@ -0,0 +1,93 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm"
+)
+
+// ErrStaleVersion is returned when a row was changed after the version the
+// client read.
+var ErrStaleVersion = errors.New("row was modified since it was read")
+
+var deletedAtType = reflect.TypeOf(gorm.DeletedAt{})
+
+// UpdateChanged writes the columns of after that differ from before and
+// increments the row's version, provided the stored row is still at
+// version. On success after holds the new version. Conditions already on db, such as an organization,
+// restrict the update too. A row at another version gives ErrStaleVersion,
+// a missing one gorm.ErrRecordNotFound.
+func UpdateChanged(db *gorm.DB, before, after interface{}, version int64) error {
+	db = db.Session(&gorm.Session{})
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(after); err != nil {
+		return err
+	}
+	versionField := stmt.Schema.LookUpField("version")
+	idField := stmt.Schema.PrioritizedPrimaryField
+	if versionField == nil || idField == nil {
+		return fmt.Errorf("%s has no id and version columns", stmt.Schema.Table)
+	}
+
+	ctx := db.Statement.Context
+	beforeValue := reflect.Indirect(reflect.ValueOf(before))
+	afterValue := reflect.Indirect(reflect.ValueOf(after))
+
+	columns := []string{versionField.DBName}
+	for _, name := range stmt.Schema.DBNames {
+		f := stmt.Schema.FieldsByDBName[name]
+		if f.PrimaryKey || f == versionField || !f.Updatable || f.AutoCreateTime > 0 || f.AutoUpdateTime > 0 || f.FieldType == deletedAtType {
+			continue
+		}
+		old, _ := f.ValueOf(ctx, beforeValue)
+		cur, _ := f.ValueOf(ctx, afterValue)
+		if !reflect.DeepEqual(old, cur) {
+			columns = append(columns, name)
+		}
+	}
+
+	if err := versionField.Set(ctx, afterValue, version+1); err != nil {
+		return err
+	}
+	result := db.Model(after).Select(columns).Where(versionField.DBName+" = ?", version).Updates(after)
+	if result.Error == nil && result.RowsAffected > 0 {
+		return nil
+	}
+	if err := versionField.Set(ctx, afterValue, version); err != nil {
+		return err
+	}
+	if result.Error != nil {
+		return result.Error
+	}
+	id, _ := idField.ValueOf(ctx, afterValue)
+	return versionError(db, reflect.New(stmt.Schema.ModelType).Interface(), id)
+}
+
+// DeleteVersioned deletes the row of model with id, provided it is at
+// version. Conditions already on db restrict the delete too.
+func DeleteVersioned(db *gorm.DB, model interface{}, id int64, version int64) error {
+	db = db.Session(&gorm.Session{})
+	result := db.Where("id = ? AND version = ?", id, version).Delete(model)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return versionError(db, model, id)
+	}
+	return nil
+}
+
+// versionError explains why the row of model with id was not at the
+// expected version: it was either deleted or changed.
+func versionError(db *gorm.DB, model interface{}, id interface{}) error {
+	var versions []int64
+	if err := db.Model(model).Where("id = ?", id).Pluck("version", &versions).Error; err != nil {
+		return err
+	}
+	if len(versions) == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return ErrStaleVersion
+}
@@ -0,0 +1,66 @@
+package data
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"persacc/internal/data/datatest"
+
+	"gorm.io/gorm"
+)
+
+type item struct {
+	ID          int64
+	Name        string
+	Description *string
+	Version     int64
+	UpdatedAt   time.Time
+	DeletedAt   gorm.DeletedAt
+}
+
+func TestUpdateChangedWritesOnlyChangedColumns(t *testing.T) {
+	db, rec := datatest.DryRun(t)
+	desc := "old"
+	before := item{ID: 7, Name: "chair", Description: &desc, Version: 3}
+	after := before
+	after.Description = nil
+
+	// A dry run affects no rows, so the update ends as not found
+	_ = UpdateChanged(db, &before, &after, 3)
+	if len(rec.Statements) == 0 {
+		t.Fatal("no statements")
+	}
+	got := rec.Statements[0]
+	for _, want := range []string{`"description"=NULL`, `"version"=4`, `"updated_at"=`, `version = 3`, `"id" = 7`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("update %q lacks %q", got, want)
+		}
+	}
+	if strings.Contains(got, `"name"=`) {
+		t.Errorf("update %q writes the unchanged name", got)
+	}
+	if after.Version != 3 {
+		t.Errorf("version = %d after a failed update, want 3", after.Version)
+	}
+}
+
+func TestUpdateChangedUsesClientVersion(t *testing.T) {
+	db, rec := datatest.DryRun(t)
+	before := item{ID: 7, Name: "chair", Version: 3}
+	after := before
+	after.Name = "table"
+
+	_ = UpdateChanged(db, &before, &after, 2)
+	if got := rec.Statements[0]; !strings.Contains(got, `version = 2`) || !strings.Contains(got, `"name"='table'`) {
+		t.Errorf("update %q", got)
+	}
+}
+
+func TestDeleteVersioned(t *testing.T) {
+	db, rec := datatest.DryRun(t)
+	_ = DeleteVersioned(db, &item{}, 7, 5)
+	if got := rec.Statements[0]; !strings.Contains(got, "version = 5") {
+		t.Errorf("delete %q lacks the version check", got)
+	}
+}
@@ -21,6 +21,7 @@ type Customer struct {
 	UserID         *int64                 `gorm:"type:bigint;unique;default:null"`
 	CreatedAt      time.Time              `gorm:"not null;default:now()"`
 	UpdatedAt      time.Time              `gorm:"not null;default:now()"`
+	Version        int64                  `gorm:"not null;default:1"`
 	DeletedAt      gorm.DeletedAt         `gorm:"index"`
 }
 
@@ -11,8 +11,11 @@ type Organization struct {
 	OwnerID     int64          `gorm:"type:bigint;not null;index"`
 	Name        string         `gorm:"type:varchar(255);uniqueIndex;not null"`
 	Description string         `gorm:"type:text"`
+	SKUPattern  string         `gorm:"type:varchar(255);not null;default:''"`
+	SKUSequence int64          `gorm:"not null;default:0"`
 	CreatedAt   time.Time      `gorm:"not null;default:now()"`
 	UpdatedAt   time.Time      `gorm:"not null;default:now()"`
+	Version     int64          `gorm:"not null;default:1"`
 	DeletedAt   gorm.DeletedAt `gorm:"index"`
 	Owner       User           `gorm:"foreignKey:OwnerID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
 }
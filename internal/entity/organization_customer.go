@@ -6,14 +6,21 @@ import (
 	"gorm.io/gorm"
 )
 
+// OrganizationCustomer links a customer to an organization. A customer may
+// be linked to several organizations; each keeps its own description, tags
+// and notes on the link.
 type OrganizationCustomer struct {
 	ID             int64          `gorm:"primaryKey;type:bigint;autoIncrement"`
 	OrganizationID int64          `gorm:"type:bigint;not null"`
 	CustomerID     int64          `gorm:"type:bigint;not null"`
 	Description    string         `gorm:"type:text"`
+	Tags           []string       `gorm:"type:jsonb;serializer:json"`
+	Notes          string         `gorm:"type:text"`
 	CreatedAt      time.Time      `gorm:"not null;default:now()"`
 	UpdatedAt      time.Time      `gorm:"not null;default:now()"`
+	Version        int64          `gorm:"not null;default:1"`
 	DeletedAt      gorm.DeletedAt `gorm:"index"`
+	Customer       Customer       `gorm:"foreignKey:CustomerID"`
 }
 
 func (OrganizationCustomer) TableName() string {
@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"time"
+)
+
+// Organization roles. The owner role is implied by Organization.OwnerID and
+// is never stored in organization_users.
+const (
+	OrganizationRoleOwner      = "owner"
+	OrganizationRoleManager    = "manager"
+	OrganizationRoleAccountant = "accountant"
+	OrganizationRoleViewer     = "viewer"
+)
+
+type OrganizationUser struct {
+	ID             int64     `gorm:"primaryKey;type:bigint;autoIncrement"`
+	OrganizationID int64     `gorm:"type:bigint;not null;uniqueIndex:idx_organization_users_org_user"`
+	UserID         int64     `gorm:"type:bigint;not null;uniqueIndex:idx_organization_users_org_user;index"`
+	Role           string    `gorm:"type:varchar(50);not null;default:viewer"`
+	CreatedAt      time.Time `gorm:"not null;default:now()"`
+	UpdatedAt      time.Time `gorm:"not null;default:now()"`
+	User           User      `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+func (OrganizationUser) TableName() string {
+	return "organization_users"
+}
+
+// IsAssignableOrganizationRole reports whether role may be given to a member.
+func IsAssignableOrganizationRole(role string) bool {
+	switch role {
+	case OrganizationRoleManager, OrganizationRoleAccountant, OrganizationRoleViewer:
+		return true
+	}
+	return false
+}
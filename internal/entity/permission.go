@@ -12,6 +12,7 @@ type Permission struct {
 	Description string `gorm:"type:text"`
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	Version     int64          `gorm:"not null;default:1"`
 	DeletedAt   gorm.DeletedAt `gorm:"index"`
 }
 
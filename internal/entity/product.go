@@ -8,12 +8,13 @@ import (
 
 type Product struct {
 	ID             int64          `gorm:"primaryKey;autoIncrement"`
-	OrganizationID int64          `gorm:"not null;index"`
-	SKU            string         `gorm:"type:varchar(255);not null;uniqueIndex"`
+	OrganizationID int64          `gorm:"not null;index;uniqueIndex:idx_products_organization_id_sku,priority:1,where:deleted_at IS NULL"`
+	SKU            string         `gorm:"type:varchar(255);not null;uniqueIndex:idx_products_organization_id_sku,priority:2"`
 	Name           string         `gorm:"type:varchar(255);not null"`
 	Description    *string        `gorm:"type:text"`
 	CreatedAt      time.Time      `gorm:"not null;default:now()"`
 	UpdatedAt      time.Time      `gorm:"not null;default:now()"`
+	Version        int64          `gorm:"not null;default:1"`
 	DeletedAt         gorm.DeletedAt `gorm:"index"`
 	ProductDetails    *ProductDetail `gorm:"foreignKey:ProductID"`
 	CategoryID        *int64         `gorm:"index;default:null"`
@@ -12,6 +12,7 @@ type Role struct {
 	Permissions []Permission `gorm:"many2many:role_permissions;"`
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	Version     int64          `gorm:"not null;default:1"`
 	DeletedAt   gorm.DeletedAt `gorm:"index"`
 }
 
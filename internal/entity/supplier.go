@@ -15,6 +15,7 @@ type Supplier struct {
 	OrganizationID *int64         `gorm:"index"`
 	CreatedAt      time.Time      `gorm:"not null;default:now()"`
 	UpdatedAt      time.Time      `gorm:"not null;default:now()"`
+	Version        int64          `gorm:"not null;default:1"`
 	DeletedAt      gorm.DeletedAt `gorm:"index"`
 }
 
@@ -15,6 +15,7 @@ type User struct {
 	Role      Role   `gorm:"foreignKey:RoleID"`
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	Version   int64          `gorm:"not null;default:1"`
 	DeletedAt gorm.DeletedAt `gorm:"index"`
 }
 
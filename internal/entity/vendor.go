@@ -13,6 +13,7 @@ type Vendor struct {
 	Description *string        `gorm:"type:text"`
 	CreatedAt   time.Time      `gorm:"not null;default:now()"`
 	UpdatedAt   time.Time      `gorm:"not null;default:now()"`
+	Version     int64          `gorm:"not null;default:1"`
 	DeletedAt   gorm.DeletedAt `gorm:"index"`
 }
 
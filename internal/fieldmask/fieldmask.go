@@ -0,0 +1,70 @@
+// Package fieldmask interprets the update_mask of the Update RPCs.
+//
+// Fields listed in a mask are written even when the request leaves them
+// empty, which is how clients clear a value. Without a mask an Update keeps
+// its original behaviour of writing only the fields set to non-empty
+// values. The path "*" selects every field.
+package fieldmask
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Wildcard is the path that selects every field.
+const Wildcard = "*"
+
+// Error reports a path that the request cannot update.
+type Error struct {
+	Path string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("unknown field %q", e.Path)
+}
+
+// Mask is a validated update mask.
+type Mask struct {
+	paths map[string]bool
+	all   bool
+}
+
+// New validates m against the fields an Update request can write.
+func New(m *fieldmaskpb.FieldMask, fields ...string) (Mask, error) {
+	var mask Mask
+	if len(m.GetPaths()) == 0 {
+		return mask, nil
+	}
+	known := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		known[f] = true
+	}
+	mask.paths = make(map[string]bool, len(m.GetPaths()))
+	for _, p := range m.GetPaths() {
+		switch {
+		case p == Wildcard:
+			mask.all = true
+		case known[p]:
+			mask.paths[p] = true
+		default:
+			return Mask{}, &Error{Path: p}
+		}
+	}
+	return mask, nil
+}
+
+// Updates reports whether the field at path is written: whether the mask
+// lists it or, without a mask, whether the request set it to a non-empty
+// value.
+func (m Mask) Updates(path string, set bool) bool {
+	if m.paths == nil {
+		return set
+	}
+	return m.all || m.paths[path]
+}
+
+// Empty reports whether no mask was given.
+func (m Mask) Empty() bool {
+	return m.paths == nil
+}
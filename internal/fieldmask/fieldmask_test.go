@@ -0,0 +1,47 @@
+package fieldmask
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestUpdatesWithoutMask(t *testing.T) {
+	m, err := New(nil, "name", "description")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !m.Empty() || !m.Updates("name", true) || m.Updates("description", false) {
+		t.Error("without a mask only set fields should be updated")
+	}
+}
+
+func TestUpdatesWithMask(t *testing.T) {
+	m, err := New(&fieldmaskpb.FieldMask{Paths: []string{"description"}}, "name", "description")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !m.Updates("description", false) {
+		t.Error("a listed field should be updated even when empty")
+	}
+	if m.Updates("name", true) {
+		t.Error("an unlisted field should not be updated")
+	}
+
+	all, err := New(&fieldmaskpb.FieldMask{Paths: []string{"*"}}, "name", "description")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !all.Updates("name", false) || !all.Updates("description", false) {
+		t.Error("the wildcard should update every field")
+	}
+}
+
+func TestUnknownPath(t *testing.T) {
+	_, err := New(&fieldmaskpb.FieldMask{Paths: []string{"name", "price"}}, "name")
+	var maskErr *Error
+	if !errors.As(err, &maskErr) || maskErr.Path != "price" {
+		t.Fatalf("got %v, want an error for price", err)
+	}
+}
@@ -0,0 +1,353 @@
+// Package listquery parses the filter and order_by parameters of the List
+// RPCs against the fields each list exposes.
+//
+// A filter is one or more comparisons joined by AND:
+//
+//	name = "Blue chair"            exact match
+//	sku = "CH-*"                   prefix match (string fields)
+//	created_at >= 2024-01-01       range: <, <=, >, >=, and != for inequality
+//	category_id IN (3, 4)          any of the values
+//
+// Strings may be quoted with double quotes; times are RFC 3339 timestamps
+// or dates. order_by names one sortable field, optionally followed by asc
+// or desc.
+package listquery
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"persacc/internal/pagination"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Kind is the type of a field's values.
+type Kind int
+
+const (
+	String Kind = iota
+	Int
+	Time
+)
+
+// Field is a column that can be filtered and, if Sortable, ordered by.
+// Only columns that cannot be NULL may be sortable.
+type Field struct {
+	Column   string
+	Kind     Kind
+	Sortable bool
+}
+
+// Fields are the fields of a list by the name clients use.
+type Fields map[string]Field
+
+// Request holds the raw filter and order_by parameters.
+type Request struct {
+	Filter  string
+	OrderBy string
+}
+
+// Error reports an invalid filter or order_by parameter.
+type Error struct {
+	// Param is "filter" or "order_by".
+	Param  string
+	Reason string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Param, e.Reason)
+}
+
+// Query is a parsed, validated Request.
+type Query struct {
+	conditions []condition
+	Order      pagination.Order
+}
+
+// comparisons are the operators Apply may write into SQL as they are.
+var comparisons = map[string]bool{"=": true, "!=": true, "<": true, "<=": true, ">": true, ">=": true}
+
+type condition struct {
+	column string
+	op     string
+	values []interface{}
+}
+
+// Parse validates req against fs.
+func (fs Fields) Parse(req Request) (*Query, error) {
+	q := &Query{Order: pagination.ByID}
+	var err error
+	if q.conditions, err = fs.parseFilter(req.Filter); err != nil {
+		return nil, &Error{Param: "filter", Reason: err.Error()}
+	}
+	if q.Order, err = fs.parseOrder(req.OrderBy); err != nil {
+		return nil, &Error{Param: "order_by", Reason: err.Error()}
+	}
+	return q, nil
+}
+
+// Apply adds the filter conditions to db. Columns are qualified with the
+// table of db's model so that joined lists stay unambiguous.
+func (q *Query) Apply(db *gorm.DB) *gorm.DB {
+	for _, c := range q.conditions {
+		col := clause.Column{Table: clause.CurrentTable, Name: c.column}
+		switch c.op {
+		case "IN":
+			db = db.Where(clause.IN{Column: col, Values: c.values})
+		case "PREFIX":
+			db = db.Where(clause.Expr{SQL: "? LIKE ?", Vars: []interface{}{col, c.values[0]}})
+		default:
+			db = db.Where(clause.Expr{SQL: "? " + c.op + " ?", Vars: []interface{}{col, c.values[0]}})
+		}
+	}
+	return db
+}
+
+func (fs Fields) parseOrder(orderBy string) (pagination.Order, error) {
+	words := strings.Fields(orderBy)
+	if len(words) == 0 {
+		return pagination.ByID, nil
+	}
+	if len(words) > 2 || strings.Contains(orderBy, ",") {
+		return pagination.Order{}, fmt.Errorf("only one field can be ordered by")
+	}
+	f, ok := fs[words[0]]
+	if !ok || !f.Sortable {
+		return pagination.Order{}, fmt.Errorf("cannot order by %q, use one of %s", words[0], strings.Join(fs.sortable(), ", "))
+	}
+	order := pagination.Order{Column: f.Column}
+	if len(words) == 2 {
+		switch strings.ToLower(words[1]) {
+		case "asc":
+		case "desc":
+			order.Desc = true
+		default:
+			return pagination.Order{}, fmt.Errorf("direction must be asc or desc, got %q", words[1])
+		}
+	}
+	return order, nil
+}
+
+func (fs Fields) sortable() []string {
+	var names []string
+	for name, f := range fs {
+		if f.Sortable {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (fs Fields) parseFilter(filter string) ([]condition, error) {
+	tokens, err := tokenize(filter)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	var conds []condition
+	for !p.done() {
+		if len(conds) > 0 && !p.keyword("AND") {
+			return nil, fmt.Errorf("expected AND, got %q", p.peek().text)
+		}
+		c, err := fs.parseCondition(p)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, c)
+	}
+	return conds, nil
+}
+
+func (fs Fields) parseCondition(p *parser) (condition, error) {
+	name := p.next()
+	if name.kind != word {
+		return condition{}, fmt.Errorf("expected a field name, got %q", name.text)
+	}
+	f, ok := fs[name.text]
+	if !ok {
+		return condition{}, fmt.Errorf("unknown field %q", name.text)
+	}
+	c := condition{column: f.Column}
+
+	if p.keyword("IN") {
+		c.op = "IN"
+		if p.next().kind != openParen {
+			return c, fmt.Errorf("expected ( after IN")
+		}
+		for {
+			v, err := f.value(p.next())
+			if err != nil {
+				return c, err
+			}
+			c.values = append(c.values, v)
+			t := p.next()
+			if t.kind == closeParen {
+				return c, nil
+			}
+			if t.kind != comma {
+				return c, fmt.Errorf("expected , or ) in IN list")
+			}
+		}
+	}
+
+	op := p.next()
+	if op.kind != operator {
+		return c, fmt.Errorf("expected an operator after %s, got %q", name.text, op.text)
+	}
+	if !comparisons[op.text] {
+		return c, fmt.Errorf("unknown operator %s", op.text)
+	}
+	c.op = op.text
+	raw := p.next()
+
+	if f.Kind == String && c.op == "=" && (raw.kind == word || raw.kind == quoted) && strings.HasSuffix(raw.text, "*") {
+		c.op = "PREFIX"
+		c.values = []interface{}{escapeLike(strings.TrimSuffix(raw.text, "*")) + "%"}
+		return c, nil
+	}
+	if f.Kind == String && c.op != "=" && c.op != "!=" {
+		return c, fmt.Errorf("%s only supports =, != and IN", name.text)
+	}
+	v, err := f.value(raw)
+	if err != nil {
+		return c, err
+	}
+	c.values = []interface{}{v}
+	return c, nil
+}
+
+// value converts t to the Go type of the field.
+func (f Field) value(t token) (interface{}, error) {
+	if t.kind != word && t.kind != quoted {
+		return nil, fmt.Errorf("expected a value, got %q", t.text)
+	}
+	switch f.Kind {
+	case Int:
+		n, err := strconv.ParseInt(t.text, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", t.text)
+		}
+		return n, nil
+	case Time:
+		for _, layout := range []string{time.RFC3339Nano, time.DateOnly} {
+			if ts, err := time.Parse(layout, t.text); err == nil {
+				return ts, nil
+			}
+		}
+		return nil, fmt.Errorf("%q is not a timestamp (RFC 3339) or date (YYYY-MM-DD)", t.text)
+	default:
+		return t.text, nil
+	}
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+type tokenKind int
+
+const (
+	eof tokenKind = iota
+	word
+	quoted
+	operator
+	openParen
+	closeParen
+	comma
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{openParen, "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{closeParen, ")"})
+			i++
+		case c == ',':
+			tokens = append(tokens, token{comma, ","})
+			i++
+		case c == '"':
+			var b strings.Builder
+			i++
+			for ; i < len(s) && s[i] != '"'; i++ {
+				if s[i] == '\\' && i+1 < len(s) {
+					i++
+				}
+				b.WriteByte(s[i])
+			}
+			if i == len(s) {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			i++
+			tokens = append(tokens, token{quoted, b.String()})
+		case strings.ContainsRune("=!<>", rune(c)):
+			op := string(c)
+			if i+1 < len(s) && s[i+1] == '=' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, fmt.Errorf("unknown operator !")
+			}
+			tokens = append(tokens, token{operator, op})
+			i += len(op)
+		default:
+			start := i
+			for i < len(s) && !unicode.IsSpace(rune(s[i])) && !strings.ContainsRune(`()",=!<>`, rune(s[i])) {
+				i++
+			}
+			tokens = append(tokens, token{word, s[start:i]})
+		}
+	}
+	return tokens, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) done() bool { return p.pos >= len(p.tokens) }
+
+func (p *parser) peek() token {
+	if p.done() {
+		return token{eof, "end of filter"}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.peek()
+	if !p.done() {
+		p.pos++
+	}
+	return t
+}
+
+// keyword consumes the next token if it is the case-insensitive word kw.
+func (p *parser) keyword(kw string) bool {
+	if t := p.peek(); t.kind == word && strings.EqualFold(t.text, kw) {
+		p.pos++
+		return true
+	}
+	return false
+}
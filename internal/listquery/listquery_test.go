@@ -0,0 +1,96 @@
+package listquery
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"persacc/internal/data/datatest"
+	"persacc/internal/pagination"
+
+	"gorm.io/gorm"
+)
+
+type item struct {
+	ID         int64
+	Name       string
+	CategoryID *int64
+	CreatedAt  time.Time
+}
+
+var itemFields = Fields{
+	"id":          {Column: "id", Kind: Int},
+	"name":        {Column: "name", Kind: String, Sortable: true},
+	"category_id": {Column: "category_id", Kind: Int},
+	"created_at":  {Column: "created_at", Kind: Time, Sortable: true},
+}
+
+func toSQL(t *testing.T, q *Query) string {
+	t.Helper()
+	db, _ := datatest.DryRun(t)
+	return db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return q.Apply(tx.Model(&item{})).Find(&[]item{})
+	})
+}
+
+func TestParse(t *testing.T) {
+	q, err := itemFields.Parse(Request{
+		Filter:  `name = "Blue_*" and category_id IN (3, 4) AND created_at >= 2024-01-01 AND name != x`,
+		OrderBy: "created_at desc",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Order != (pagination.Order{Column: "created_at", Desc: true}) {
+		t.Errorf("order = %+v", q.Order)
+	}
+	got := toSQL(t, q)
+	for _, want := range []string{
+		`"items"."name" LIKE 'Blue\_%'`,
+		`"items"."category_id" IN (3,4)`,
+		`"items"."created_at" >= '2024-01-01 00:00:00'`,
+		`"items"."name" != 'x'`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("query %q lacks %q", got, want)
+		}
+	}
+}
+
+func TestParseDefaults(t *testing.T) {
+	q, err := itemFields.Parse(Request{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(q.conditions) != 0 || q.Order != pagination.ByID {
+		t.Errorf("got %+v", q)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		req   Request
+		param string
+	}{
+		{Request{Filter: "price > 3"}, "filter"},
+		{Request{Filter: "name > b"}, "filter"},
+		{Request{Filter: "category_id = three"}, "filter"},
+		{Request{Filter: "created_at < yesterday"}, "filter"},
+		{Request{Filter: "name = a OR name = b"}, "filter"},
+		{Request{Filter: "category_id IN (1, 2"}, "filter"},
+		{Request{Filter: `name = "open`}, "filter"},
+		{Request{Filter: "name ="}, "filter"},
+		{Request{Filter: "id == 3"}, "filter"},
+		{Request{OrderBy: "category_id"}, "order_by"},
+		{Request{OrderBy: "name sideways"}, "order_by"},
+		{Request{OrderBy: "name, created_at"}, "order_by"},
+	}
+	for _, tt := range tests {
+		_, err := itemFields.Parse(tt.req)
+		var lqErr *Error
+		if !errors.As(err, &lqErr) || lqErr.Param != tt.param {
+			t.Errorf("Parse(%+v) = %v, want an error for %s", tt.req, err, tt.param)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package logging
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// RequestIDHeader carries the request ID to and from clients.
+const RequestIDHeader = "X-Request-Id"
+
+// quietPaths are polled by orchestrators and only logged at debug level.
+var quietPaths = map[string]bool{
+	"/healthz": true,
+	"/readyz":  true,
+	"/metrics": true,
+}
+
+// HTTPMiddleware assigns every request an ID, taken from the X-Request-Id
+// header when the client sent one, echoes it in the response and logs the
+// request once it is served. gRPC requests are logged at debug level since
+// the RPC itself is logged by the gRPC interceptor.
+func HTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		id := strings.TrimSpace(r.Header.Get(RequestIDHeader))
+		if id == "" || len(id) > 128 {
+			id = NewRequestID()
+		}
+		ctx := WithRequestID(r.Context(), id)
+		w.Header().Set(RequestIDHeader, id)
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r.WithContext(ctx))
+
+		level := slog.LevelInfo
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") || quietPaths[r.URL.Path] {
+			level = slog.LevelDebug
+		}
+		attrs := []slog.Attr{
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.String("proto", r.Proto),
+			slog.String("remote_addr", r.RemoteAddr),
+			slog.Int("status", rec.status),
+			slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+		}
+		if grpcStatus := rec.Header().Get("Grpc-Status"); grpcStatus != "" {
+			attrs = append(attrs, slog.String("grpc_status", grpcStatus))
+		}
+		if slog.Default().Enabled(ctx, slog.LevelDebug) {
+			attrs = append(attrs, headerAttrs(r.Header))
+		}
+		slog.LogAttrs(ctx, level, "http request", attrs...)
+	})
+}
+
+// headerAttrs groups request headers; credentials are removed by the
+// handler's redaction like any other attribute.
+func headerAttrs(h http.Header) slog.Attr {
+	attrs := make([]any, 0, len(h))
+	for name, values := range h {
+		attrs = append(attrs, slog.String(name, strings.Join(values, ", ")))
+	}
+	return slog.Group("headers", attrs...)
+}
+
+// statusRecorder remembers the response status. It keeps the Flush support
+// gRPC and gRPC-web rely on.
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	if !r.wroteHeader {
+		r.status = code
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
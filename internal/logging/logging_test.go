@@ -0,0 +1,52 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestRedactDSN(t *testing.T) {
+	tests := []struct {
+		dsn  string
+		want string
+	}{
+		{"host=localhost user=postgres password=secret dbname=persacc", "host=localhost user=postgres password=[REDACTED] dbname=persacc"},
+		{"host=db password='se cret' port=5432", "host=db password=[REDACTED] port=5432"},
+		{"postgres://postgres:secret@db:5432/persacc?sslmode=disable", "postgres://postgres:[REDACTED]@db:5432/persacc?sslmode=disable"},
+		{"postgres://db/persacc?password=secret", "postgres://db/persacc?password=[REDACTED]"},
+		{"host=localhost dbname=persacc", "host=localhost dbname=persacc"},
+	}
+	for _, tt := range tests {
+		if got := RedactDSN(tt.dsn); got != tt.want {
+			t.Errorf("RedactDSN(%q) = %q, want %q", tt.dsn, got, tt.want)
+		}
+	}
+}
+
+func TestLoggerRedactsAndAddsRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(&buf, "debug", "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := WithRequestID(context.Background(), "req-1")
+	logger.InfoContext(ctx, "http request", slog.Group("headers",
+		slog.String("Authorization", "Bearer abc.def"),
+		slog.String("Cookie", "session=xyz"),
+		slog.String("Accept", "*/*"),
+	))
+
+	out := buf.String()
+	for _, secret := range []string{"abc.def", "session=xyz"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("log output leaks %q: %s", secret, out)
+		}
+	}
+	if !strings.Contains(out, `"request_id":"req-1"`) || !strings.Contains(out, `"Accept":"*/*"`) {
+		t.Errorf("unexpected log output: %s", out)
+	}
+}
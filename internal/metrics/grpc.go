@@ -0,0 +1,113 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Transports an RPC can arrive over.
+const (
+	TransportGRPC    = "grpc"
+	TransportGRPCWeb = "grpc-web"
+)
+
+var (
+	rpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rpc_requests_total",
+		Help:      "RPCs handled, by method, status code and transport.",
+	}, []string{"method", "code", "transport"})
+
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "rpc_duration_seconds",
+		Help:      "Time to handle an RPC, by method and transport.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "transport"})
+
+	rpcInFlight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "rpc_in_flight",
+		Help:      "RPCs being handled, by transport.",
+	}, []string{"transport"})
+
+	clientRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "client_rpc_requests_total",
+		Help:      "Outgoing RPCs, by target service, method and status code.",
+	}, []string{"service", "method", "code"})
+
+	clientDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "client_rpc_duration_seconds",
+		Help:      "Latency of outgoing RPCs, by target service and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "method"})
+)
+
+func init() {
+	Registry.MustRegister(rpcRequests, rpcDuration, rpcInFlight, clientRequests, clientDuration)
+}
+
+type transportKey struct{}
+
+// WithTransport records the transport of the request handled with ctx. RPCs
+// without one are counted as native gRPC.
+func WithTransport(ctx context.Context, transport string) context.Context {
+	return context.WithValue(ctx, transportKey{}, transport)
+}
+
+func transportFrom(ctx context.Context) string {
+	if t, ok := ctx.Value(transportKey{}).(string); ok {
+		return t
+	}
+	return TransportGRPC
+}
+
+// UnaryServerInterceptor records the count, status and latency of RPCs.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		done := observeRPC(ctx, info.FullMethod)
+		resp, err := handler(ctx, req)
+		done(err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor records streams like UnaryServerInterceptor.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		done := observeRPC(ss.Context(), info.FullMethod)
+		err := handler(srv, ss)
+		done(err)
+		return err
+	}
+}
+
+func observeRPC(ctx context.Context, method string) func(error) {
+	transport := transportFrom(ctx)
+	start := time.Now()
+	inFlight := rpcInFlight.WithLabelValues(transport)
+	inFlight.Inc()
+	return func(err error) {
+		inFlight.Dec()
+		rpcDuration.WithLabelValues(method, transport).Observe(time.Since(start).Seconds())
+		rpcRequests.WithLabelValues(method, status.Code(err).String(), transport).Inc()
+	}
+}
+
+// UnaryClientInterceptor records the count, status and latency of calls to
+// the named service.
+func UnaryClientInterceptor(service string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		clientDuration.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+		clientRequests.WithLabelValues(service, method, status.Code(err).String()).Inc()
+		return err
+	}
+}
@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	intercept := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/admin.AdminService/GetProduct"}
+
+	ok := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	notFound := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+
+	intercept(context.Background(), nil, info, ok)
+	intercept(WithTransport(context.Background(), TransportGRPCWeb), nil, info, ok)
+	intercept(WithTransport(context.Background(), TransportGRPCWeb), nil, info, notFound)
+
+	if got := testutil.ToFloat64(rpcRequests.WithLabelValues(info.FullMethod, "OK", TransportGRPC)); got != 1 {
+		t.Errorf("grpc OK count = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(rpcRequests.WithLabelValues(info.FullMethod, "OK", TransportGRPCWeb)); got != 1 {
+		t.Errorf("grpc-web OK count = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(rpcRequests.WithLabelValues(info.FullMethod, "NotFound", TransportGRPCWeb)); got != 1 {
+		t.Errorf("grpc-web NotFound count = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(rpcInFlight.WithLabelValues(TransportGRPCWeb)); got != 0 {
+		t.Errorf("in flight = %v, want 0", got)
+	}
+}
@@ -0,0 +1,57 @@
+// Package metrics defines the server's Prometheus metrics and the
+// instrumentation that records them.
+package metrics
+
+import (
+	"net/http"
+
+	"persacc/internal/cache"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "persacc"
+
+// Registry holds every metric of the server, plus the Go runtime and
+// process collectors.
+var Registry = prometheus.NewRegistry()
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// Handler serves the registry in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// RegisterCache exposes the hit, miss and size counters of an in-process
+// cache under the given name.
+func RegisterCache(name string, stats func() cache.Stats) {
+	labels := prometheus.Labels{"cache": name}
+	Registry.MustRegister(
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "cache_hits_total",
+			Help:        "Lookups answered by the cache.",
+			ConstLabels: labels,
+		}, func() float64 { return float64(stats().Hits) }),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "cache_misses_total",
+			Help:        "Lookups not answered by the cache.",
+			ConstLabels: labels,
+		}, func() float64 { return float64(stats().Misses) }),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace:   namespace,
+			Name:        "cache_entries",
+			Help:        "Entries held by the cache.",
+			ConstLabels: labels,
+		}, func() float64 { return float64(stats().Entries) }),
+	)
+}
@@ -0,0 +1,39 @@
+package migrate
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestEmbeddedMigrationsLoad(t *testing.T) {
+	migrations, err := load(migrationFiles)
+	if err != nil {
+		t.Fatalf("load() error = %v", err)
+	}
+	if len(migrations) == 0 {
+		t.Fatal("no migrations embedded")
+	}
+	for i := 1; i < len(migrations); i++ {
+		if migrations[i-1].Version >= migrations[i].Version {
+			t.Errorf("migrations out of order: %d before %d", migrations[i-1].Version, migrations[i].Version)
+		}
+	}
+}
+
+func TestLoadRejectsMissingDown(t *testing.T) {
+	fsys := fstest.MapFS{
+		"migrations/1_init.up.sql": {Data: []byte("SELECT 1;")},
+	}
+	if _, err := load(fsys); err == nil {
+		t.Fatal("load() accepted a migration without a down script")
+	}
+}
+
+func TestLoadRejectsBadName(t *testing.T) {
+	fsys := fstest.MapFS{
+		"migrations/init.sql": {Data: []byte("SELECT 1;")},
+	}
+	if _, err := load(fsys); err == nil {
+		t.Fatal("load() accepted a badly named file")
+	}
+}
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS organization_customers;
+DROP TABLE IF EXISTS customers;
+DROP TABLE IF EXISTS organization_users;
+DROP TABLE IF EXISTS organizations;
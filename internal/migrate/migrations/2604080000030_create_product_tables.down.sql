@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS product_details;
+DROP TABLE IF EXISTS products;
+DROP TABLE IF EXISTS product_categories;
+DROP TABLE IF EXISTS suppliers;
+DROP TABLE IF EXISTS vendors;
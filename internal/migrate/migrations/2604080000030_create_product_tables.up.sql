@@ -0,0 +1,72 @@
+CREATE TABLE IF NOT EXISTS vendors (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    domain VARCHAR(255),
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    deleted_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_vendors_deleted_at ON vendors (deleted_at);
+
+CREATE TABLE IF NOT EXISTS suppliers (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    domain VARCHAR(255),
+    phone VARCHAR(255),
+    description TEXT,
+    organization_id BIGINT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    deleted_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_suppliers_organization_id ON suppliers (organization_id);
+CREATE INDEX IF NOT EXISTS idx_suppliers_deleted_at ON suppliers (deleted_at);
+
+CREATE TABLE IF NOT EXISTS product_categories (
+    id BIGSERIAL PRIMARY KEY,
+    organization_id BIGINT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    deleted_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_product_categories_organization_id ON product_categories (organization_id);
+CREATE INDEX IF NOT EXISTS idx_product_categories_deleted_at ON product_categories (deleted_at);
+
+CREATE TABLE IF NOT EXISTS products (
+    id BIGSERIAL PRIMARY KEY,
+    organization_id BIGINT NOT NULL,
+    sku VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    deleted_at TIMESTAMPTZ,
+    category_id BIGINT DEFAULT NULL,
+    vendor_id BIGINT DEFAULT NULL,
+    vendor_product_code VARCHAR(255) DEFAULT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_sku ON products (sku);
+CREATE INDEX IF NOT EXISTS idx_products_organization_id ON products (organization_id);
+CREATE INDEX IF NOT EXISTS idx_products_category_id ON products (category_id);
+CREATE INDEX IF NOT EXISTS idx_products_vendor_id ON products (vendor_id);
+CREATE INDEX IF NOT EXISTS idx_products_deleted_at ON products (deleted_at);
+
+CREATE TABLE IF NOT EXISTS product_details (
+    id BIGSERIAL PRIMARY KEY,
+    product_id BIGINT NOT NULL,
+    additional_details JSONB
+);
+CREATE INDEX IF NOT EXISTS idx_product_details_product_id ON product_details (product_id);
+
+-- product_details may already exist without its foreign key.
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_products_product_details' AND conrelid = 'product_details'::regclass) THEN
+        ALTER TABLE product_details
+            ADD CONSTRAINT fk_products_product_details
+            FOREIGN KEY (product_id) REFERENCES products (id) ON DELETE CASCADE;
+    END IF;
+END $$;
@@ -0,0 +1,9 @@
+ALTER TABLE customers DROP COLUMN IF EXISTS version;
+ALTER TABLE products DROP COLUMN IF EXISTS version;
+ALTER TABLE product_categories DROP COLUMN IF EXISTS version;
+ALTER TABLE suppliers DROP COLUMN IF EXISTS version;
+ALTER TABLE vendors DROP COLUMN IF EXISTS version;
+ALTER TABLE permissions DROP COLUMN IF EXISTS version;
+ALTER TABLE roles DROP COLUMN IF EXISTS version;
+ALTER TABLE users DROP COLUMN IF EXISTS version;
+ALTER TABLE organizations DROP COLUMN IF EXISTS version;
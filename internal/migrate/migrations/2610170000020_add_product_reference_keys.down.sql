@@ -0,0 +1,3 @@
+ALTER TABLE products DROP CONSTRAINT IF EXISTS fk_products_vendor;
+ALTER TABLE products DROP CONSTRAINT IF EXISTS fk_products_category;
+ALTER TABLE product_categories DROP CONSTRAINT IF EXISTS uq_product_categories_organization_id_id;
@@ -0,0 +1,18 @@
+-- References that never resolved, or that point at another organization's
+-- category, are dropped so the constraints can be validated.
+UPDATE products p SET category_id = NULL
+WHERE category_id IS NOT NULL AND NOT EXISTS (
+    SELECT 1 FROM product_categories c WHERE c.id = p.category_id AND c.organization_id = p.organization_id
+);
+UPDATE products p SET vendor_id = NULL
+WHERE vendor_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM vendors v WHERE v.id = p.vendor_id);
+
+-- A product's category must belong to the product's organization.
+ALTER TABLE product_categories
+    ADD CONSTRAINT uq_product_categories_organization_id_id UNIQUE (organization_id, id);
+ALTER TABLE products
+    ADD CONSTRAINT fk_products_category
+    FOREIGN KEY (organization_id, category_id) REFERENCES product_categories (organization_id, id);
+ALTER TABLE products
+    ADD CONSTRAINT fk_products_vendor
+    FOREIGN KEY (vendor_id) REFERENCES vendors (id);
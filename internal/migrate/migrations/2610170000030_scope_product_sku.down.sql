@@ -0,0 +1,6 @@
+ALTER TABLE organizations DROP COLUMN IF EXISTS sku_sequence;
+ALTER TABLE organizations DROP COLUMN IF EXISTS sku_pattern;
+
+-- Fails if organizations now share a SKU, or reuse that of a deleted product.
+DROP INDEX IF EXISTS idx_products_organization_id_sku;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_sku ON products (sku);
@@ -0,0 +1,8 @@
+-- SKUs are unique within an organization, and deleted products release
+-- theirs.
+DROP INDEX IF EXISTS idx_products_sku;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_organization_id_sku
+    ON products (organization_id, sku) WHERE deleted_at IS NULL;
+
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS sku_pattern VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS sku_sequence BIGINT NOT NULL DEFAULT 0;
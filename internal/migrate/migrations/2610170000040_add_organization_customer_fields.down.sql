@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_organization_customers_organization_id_customer_id;
+ALTER TABLE organization_customers DROP COLUMN IF EXISTS version;
+ALTER TABLE organization_customers DROP COLUMN IF EXISTS notes;
+ALTER TABLE organization_customers DROP COLUMN IF EXISTS tags;
@@ -0,0 +1,21 @@
+ALTER TABLE organization_customers ADD COLUMN IF NOT EXISTS tags JSONB;
+ALTER TABLE organization_customers ADD COLUMN IF NOT EXISTS notes TEXT;
+ALTER TABLE organization_customers ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+-- Deleting a customer from one organization used to delete it for every
+-- organization it was linked to. Restore the customers other organizations
+-- still link to.
+UPDATE customers c SET deleted_at = NULL
+WHERE c.deleted_at IS NOT NULL AND EXISTS (
+    SELECT 1 FROM organization_customers oc WHERE oc.customer_id = c.id AND oc.deleted_at IS NULL
+);
+
+-- A customer is linked to an organization at most once.
+UPDATE organization_customers oc SET deleted_at = now()
+WHERE oc.deleted_at IS NULL AND EXISTS (
+    SELECT 1 FROM organization_customers o
+    WHERE o.organization_id = oc.organization_id AND o.customer_id = oc.customer_id
+      AND o.deleted_at IS NULL AND o.id < oc.id
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_organization_customers_organization_id_customer_id
+    ON organization_customers (organization_id, customer_id) WHERE deleted_at IS NULL;
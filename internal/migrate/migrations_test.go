@@ -0,0 +1,53 @@
+package migrate_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"persacc/internal/data/datatest"
+	"persacc/internal/migrate"
+)
+
+func insert(t *testing.T, db *sql.DB, query string, args ...interface{}) int64 {
+	t.Helper()
+	var id int64
+	if err := db.QueryRow(query+" RETURNING id", args...).Scan(&id); err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+// Customers deleted from one organization while others still linked to
+// them are restored.
+func TestAddOrganizationCustomerFieldsRestoresLinkedCustomers(t *testing.T) {
+	db := datatest.PostgresDB(t)
+	ctx := context.Background()
+	m, err := migrate.New(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.To(ctx, 2610170000030); err != nil {
+		t.Fatal(err)
+	}
+
+	owner := insert(t, db, "INSERT INTO users (email) VALUES ('owner@example.com')")
+	org := insert(t, db, "INSERT INTO organizations (owner_id, name) VALUES ($1, 'Acme')", owner)
+	linked := insert(t, db, "INSERT INTO customers (name, deleted_at) VALUES ('Linked', now())")
+	unlinked := insert(t, db, "INSERT INTO customers (name, deleted_at) VALUES ('Unlinked', now())")
+	insert(t, db, "INSERT INTO organization_customers (organization_id, customer_id) VALUES ($1, $2)", org, linked)
+	insert(t, db, "INSERT INTO organization_customers (organization_id, customer_id, deleted_at) VALUES ($1, $2, now())", org, unlinked)
+
+	if _, err := m.To(ctx, 2610170000040); err != nil {
+		t.Fatal(err)
+	}
+	for id, want := range map[int64]bool{linked: false, unlinked: true} {
+		var deleted bool
+		if err := db.QueryRow("SELECT deleted_at IS NOT NULL FROM customers WHERE id = $1", id).Scan(&deleted); err != nil {
+			t.Fatal(err)
+		}
+		if deleted != want {
+			t.Errorf("customer %d deleted = %v, want %v", id, deleted, want)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+// Package pagination pages the results of the List RPCs, either by page
+// number or, with page tokens, by keyset: each page starts after the sort
+// key and id of the previous page's last row, so rows inserted or deleted
+// between requests neither shift nor repeat results.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DefaultLimit is the page size when the request does not set one.
+const DefaultLimit = 10
+
+// MaxLimit is the largest page size a request may ask for.
+const MaxLimit = 100
+
+// ErrInvalidToken is returned for page tokens that cannot be decoded or
+// were issued for a different ordering.
+var ErrInvalidToken = errors.New("invalid page token")
+
+// Request selects a page. A Token takes precedence over Page.
+type Request struct {
+	Page  int
+	Limit int
+	Token string
+}
+
+// NewRequest returns the request for the paging fields of a List RPC,
+// applying the defaults. Page is zero in token mode.
+func NewRequest(page, limit int32, token string) Request {
+	r := Request{Page: int(page), Limit: int(limit), Token: token}
+	switch {
+	case r.Limit <= 0:
+		r.Limit = DefaultLimit
+	case r.Limit > MaxLimit:
+		r.Limit = MaxLimit
+	}
+	switch {
+	case token != "":
+		r.Page = 0
+	case r.Page <= 0:
+		r.Page = 1
+	}
+	return r
+}
+
+// Offset is the number of rows before the page in page number mode.
+func (r Request) Offset() int {
+	return (r.Page - 1) * r.Limit
+}
+
+// Order sorts a list by Column, then by id to make the order total. Column
+// must not be nullable.
+type Order struct {
+	Column string
+	Desc   bool
+}
+
+// ByID is the default order of every list.
+var ByID = Order{Column: "id"}
+
+func (o Order) String() string {
+	if o.Desc {
+		return o.Column + " desc"
+	}
+	return o.Column
+}
+
+// Page is one page of a list. Total is only counted for requests without a
+// token, so keyset clients get it with their first page.
+type Page[T any] struct {
+	Items         []T
+	Total         int64
+	NextPageToken string
+}
+
+// cursor is the position after the last row of a page.
+type cursor struct {
+	Order string          `json:"o"`
+	Value json.RawMessage `json:"v,omitempty"`
+	ID    int64           `json:"id"`
+}
+
+func encodeCursor(c cursor) (string, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeCursor(token string) (cursor, error) {
+	var c cursor
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, ErrInvalidToken
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, ErrInvalidToken
+	}
+	return c, nil
+}
+
+// Find loads the page of query selected by req, sorted by order. The model
+// of query must be T; preloads are loaded for the page's rows only.
+func Find[T any](query *gorm.DB, req Request, order Order, preloads ...string) (*Page[T], error) {
+	stmt := &gorm.Statement{DB: query}
+	if err := stmt.Parse(new(T)); err != nil {
+		return nil, err
+	}
+	sortField := stmt.Schema.LookUpField(order.Column)
+	idField := stmt.Schema.LookUpField("id")
+	if sortField == nil || idField == nil {
+		return nil, fmt.Errorf("cannot sort %s by %q", stmt.Schema.Table, order.Column)
+	}
+
+	var page Page[T]
+	if req.Token == "" {
+		if err := query.Session(&gorm.Session{}).Count(&page.Total).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	sortColumn := clause.Column{Table: clause.CurrentTable, Name: sortField.DBName}
+	idColumn := clause.Column{Table: clause.CurrentTable, Name: idField.DBName}
+	query = query.Order(clause.OrderBy{Columns: []clause.OrderByColumn{
+		{Column: sortColumn, Desc: order.Desc},
+		{Column: idColumn, Desc: order.Desc},
+	}})
+
+	if req.Token != "" {
+		after, err := decodeCursor(req.Token)
+		if err != nil || after.Order != order.String() {
+			return nil, ErrInvalidToken
+		}
+		value := reflect.New(sortField.FieldType)
+		if err := json.Unmarshal(after.Value, value.Interface()); err != nil {
+			return nil, ErrInvalidToken
+		}
+		op := ">"
+		if order.Desc {
+			op = "<"
+		}
+		query = query.Where(clause.Expr{
+			SQL:  "(?, ?) " + op + " (?, ?)",
+			Vars: []interface{}{sortColumn, idColumn, value.Elem().Interface(), after.ID},
+		})
+	} else {
+		query = query.Offset(req.Offset())
+	}
+
+	for _, p := range preloads {
+		query = query.Preload(p)
+	}
+	// One row more than asked for tells whether another page follows
+	if err := query.Limit(req.Limit + 1).Find(&page.Items).Error; err != nil {
+		return nil, err
+	}
+	if len(page.Items) <= req.Limit {
+		return &page, nil
+	}
+	page.Items = page.Items[:req.Limit]
+
+	last := reflect.ValueOf(&page.Items[req.Limit-1]).Elem()
+	sortValue, _ := sortField.ValueOf(query.Statement.Context, last)
+	idValue, _ := idField.ValueOf(query.Statement.Context, last)
+	value, err := json.Marshal(sortValue)
+	if err != nil {
+		return nil, err
+	}
+	id, _ := idValue.(int64)
+	if page.NextPageToken, err = encodeCursor(cursor{Order: order.String(), Value: value, ID: id}); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
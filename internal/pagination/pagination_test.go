@@ -0,0 +1,81 @@
+package pagination
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"persacc/internal/data/datatest"
+)
+
+type item struct {
+	ID        int64
+	Name      string
+	CreatedAt time.Time
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	token, err := encodeCursor(cursor{Order: "name desc", Value: []byte(`"b"`), ID: 42})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := decodeCursor(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Order != "name desc" || string(c.Value) != `"b"` || c.ID != 42 {
+		t.Errorf("decoded %+v", c)
+	}
+	if _, err := decodeCursor("not base64!"); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("got %v, want ErrInvalidToken", err)
+	}
+}
+
+func TestFindKeyset(t *testing.T) {
+	db, rec := datatest.DryRun(t)
+	token, _ := encodeCursor(cursor{Order: "name desc", Value: []byte(`"b"`), ID: 42})
+
+	_, err := Find[item](db.Model(&item{}).Where("name <> ?", ""), Request{Limit: 5, Token: token}, Order{Column: "name", Desc: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.Statements) != 1 {
+		t.Fatalf("ran %d statements, want only the page query: %q", len(rec.Statements), rec.Statements)
+	}
+	got := rec.Statements[0]
+	for _, want := range []string{
+		`("items"."name", "items"."id") < ('b', 42)`,
+		`ORDER BY "items"."name" DESC,"items"."id" DESC`,
+		`LIMIT 6`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("query %q lacks %q", got, want)
+		}
+	}
+}
+
+func TestFindPageNumber(t *testing.T) {
+	db, rec := datatest.DryRun(t)
+	req := NewRequest(3, 0, "")
+
+	if _, err := Find[item](db.Model(&item{}), req, ByID); err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.Statements) != 2 || !strings.Contains(rec.Statements[0], "count(*)") {
+		t.Fatalf("want a count and the page query, got %q", rec.Statements)
+	}
+	if !strings.Contains(rec.Statements[1], "LIMIT 11 OFFSET 20") {
+		t.Errorf("page query %q", rec.Statements[1])
+	}
+}
+
+func TestFindRejectsTokenOfOtherOrder(t *testing.T) {
+	db, _ := datatest.DryRun(t)
+	token, _ := encodeCursor(cursor{Order: "name", Value: []byte(`"b"`), ID: 42})
+
+	_, err := Find[item](db.Model(&item{}), Request{Limit: 5, Token: token}, ByID)
+	if !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("got %v, want ErrInvalidToken", err)
+	}
+}
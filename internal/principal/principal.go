@@ -0,0 +1,130 @@
+// Package principal carries the authenticated caller of an RPC through its
+// context. The server's interceptors build the Principal step by step;
+// controllers read it with the accessor functions, which return an error
+// instead of panicking when a value was not set for the method.
+package principal
+
+import (
+	"context"
+	"errors"
+
+	"persacc/internal/entity"
+)
+
+var (
+	// ErrUnauthenticated means the context carries no verified token.
+	ErrUnauthenticated = errors.New("request is not authenticated")
+	// ErrNoUser means the token was verified but not matched to a local user,
+	// as for Register.
+	ErrNoUser = errors.New("request has no local user")
+	// ErrNoOrganization means the method is not scoped to an organization.
+	ErrNoOrganization = errors.New("request is not scoped to an organization")
+)
+
+// Claims are the identity claims of the caller's access token.
+type Claims struct {
+	Email string
+	Name  string
+	Uuid  string
+	// Exp is the token expiry as a Unix timestamp, or 0 if unknown.
+	Exp int64
+}
+
+// Principal is the caller of an RPC.
+type Principal struct {
+	Claims Claims
+	// User is the local user, loaded with its role and permissions. It is
+	// zero until the user has been synced.
+	User entity.User
+	// OrganizationID is the organization an organization scoped method acts
+	// on, or 0.
+	OrganizationID int64
+	// OrganizationRole is the caller's role in the organization the method
+	// acts on, or "".
+	OrganizationRole string
+}
+
+// Role returns the name of the user's global role.
+func (p *Principal) Role() string {
+	return p.User.Role.Name
+}
+
+// Permissions returns the names of the permissions granted by the user's
+// global role.
+func (p *Principal) Permissions() []string {
+	names := make([]string, 0, len(p.User.Role.Permissions))
+	for _, perm := range p.User.Role.Permissions {
+		names = append(names, perm.Name)
+	}
+	return names
+}
+
+// HasPermission reports whether the user's global role grants permission.
+func (p *Principal) HasPermission(permission string) bool {
+	for _, perm := range p.User.Role.Permissions {
+		if perm.Name == permission {
+			return true
+		}
+	}
+	return false
+}
+
+type contextKey struct{}
+
+// NewContext returns a context carrying p. The Principal must not be
+// modified afterwards; derive a copy and store it again instead.
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, contextKey{}, p)
+}
+
+// FromContext returns the caller of the RPC.
+func FromContext(ctx context.Context) (*Principal, error) {
+	p, ok := ctx.Value(contextKey{}).(*Principal)
+	if !ok || p == nil {
+		return nil, ErrUnauthenticated
+	}
+	return p, nil
+}
+
+// TokenClaims returns the claims of the caller's access token.
+func TokenClaims(ctx context.Context) (Claims, error) {
+	p, err := FromContext(ctx)
+	if err != nil {
+		return Claims{}, err
+	}
+	return p.Claims, nil
+}
+
+// User returns the caller's local user.
+func User(ctx context.Context) (entity.User, error) {
+	p, err := FromContext(ctx)
+	if err != nil {
+		return entity.User{}, err
+	}
+	if p.User.ID == 0 {
+		return entity.User{}, ErrNoUser
+	}
+	return p.User, nil
+}
+
+// UserID returns the ID of the caller's local user.
+func UserID(ctx context.Context) (int64, error) {
+	u, err := User(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return u.ID, nil
+}
+
+// OrganizationID returns the organization named by the organization_id
+// header of an organization scoped method.
+func OrganizationID(ctx context.Context) (int64, error) {
+	p, err := FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if p.OrganizationID == 0 {
+		return 0, ErrNoOrganization
+	}
+	return p.OrganizationID, nil
+}
@@ -0,0 +1,44 @@
+package principal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"persacc/internal/entity"
+)
+
+func TestAccessors(t *testing.T) {
+	if _, err := UserID(context.Background()); !errors.Is(err, ErrUnauthenticated) {
+		t.Errorf("UserID without principal = %v, want ErrUnauthenticated", err)
+	}
+
+	ctx := NewContext(context.Background(), &Principal{Claims: Claims{Email: "a@example.com"}})
+	if _, err := UserID(ctx); !errors.Is(err, ErrNoUser) {
+		t.Errorf("UserID without user = %v, want ErrNoUser", err)
+	}
+	if c, err := TokenClaims(ctx); err != nil || c.Email != "a@example.com" {
+		t.Errorf("TokenClaims = %+v, %v", c, err)
+	}
+
+	p := &Principal{
+		User: entity.User{ID: 3, Role: entity.Role{Name: "user", Permissions: []entity.Permission{{Name: "product.read"}}}},
+	}
+	ctx = NewContext(context.Background(), p)
+	if id, err := UserID(ctx); err != nil || id != 3 {
+		t.Errorf("UserID = %d, %v, want 3", id, err)
+	}
+	if _, err := OrganizationID(ctx); !errors.Is(err, ErrNoOrganization) {
+		t.Errorf("OrganizationID without organization = %v, want ErrNoOrganization", err)
+	}
+	if !p.HasPermission("product.read") || p.HasPermission("product.write") || p.Role() != "user" {
+		t.Errorf("unexpected role %q with permissions %v", p.Role(), p.Permissions())
+	}
+
+	scoped := *p
+	scoped.OrganizationID = 9
+	ctx = NewContext(ctx, &scoped)
+	if id, err := OrganizationID(ctx); err != nil || id != 9 {
+		t.Errorf("OrganizationID = %d, %v, want 9", id, err)
+	}
+}
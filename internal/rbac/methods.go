@@ -0,0 +1,111 @@
+package rbac
+
+import "strings"
+
+// Scope tells the interceptor where a method's organization comes from.
+type Scope int
+
+const (
+	// ScopeGlobal methods act on data that does not belong to an organization.
+	ScopeGlobal Scope = iota
+	// ScopeOrganization methods act on the organization named by the
+	// organization_id header.
+	ScopeOrganization
+	// ScopeRequest methods name the organization in the request message.
+	ScopeRequest
+)
+
+// Rule is the access requirement of a single RPC. An empty Permission means
+// any authenticated user may call the method.
+type Rule struct {
+	Permission string
+	Scope      Scope
+}
+
+const servicePrefix = "/admin.AdminService/"
+
+var publicMethods = map[string]bool{
+	servicePrefix + "OAuthRegister": true,
+	servicePrefix + "OAuthToken":    true,
+	servicePrefix + "OAuthVerify":   true,
+	servicePrefix + "OAuthRefresh":  true,
+}
+
+var methodRules = map[string]Rule{
+	servicePrefix + "Register": {},
+
+	servicePrefix + "CreateUser": {UserWrite, ScopeGlobal},
+	servicePrefix + "GetUser":    {UserRead, ScopeGlobal},
+	servicePrefix + "UpdateUser": {UserWrite, ScopeGlobal},
+	servicePrefix + "DeleteUser": {UserWrite, ScopeGlobal},
+	servicePrefix + "ListUsers":  {UserRead, ScopeGlobal},
+
+	servicePrefix + "CreateRole": {RoleWrite, ScopeGlobal},
+	servicePrefix + "GetRole":    {RoleRead, ScopeGlobal},
+	servicePrefix + "UpdateRole": {RoleWrite, ScopeGlobal},
+	servicePrefix + "DeleteRole": {RoleWrite, ScopeGlobal},
+	servicePrefix + "ListRoles":  {RoleRead, ScopeGlobal},
+
+	servicePrefix + "CreatePermission": {PermissionWrite, ScopeGlobal},
+	servicePrefix + "GetPermission":    {PermissionRead, ScopeGlobal},
+	servicePrefix + "UpdatePermission": {PermissionWrite, ScopeGlobal},
+	servicePrefix + "DeletePermission": {PermissionWrite, ScopeGlobal},
+	servicePrefix + "ListPermissions":  {PermissionRead, ScopeGlobal},
+
+	servicePrefix + "CreateOrganization": {OrganizationWrite, ScopeGlobal},
+	servicePrefix + "GetOrganization":    {OrganizationRead, ScopeRequest},
+	servicePrefix + "UpdateOrganization": {OrganizationWrite, ScopeRequest},
+	servicePrefix + "DeleteOrganization": {OrganizationDelete, ScopeRequest},
+	servicePrefix + "ListOrganizations":  {OrganizationRead, ScopeGlobal},
+
+	servicePrefix + "AddOrganizationUser":        {OrganizationMemberWrite, ScopeRequest},
+	servicePrefix + "ListOrganizationUsers":      {OrganizationMemberRead, ScopeRequest},
+	servicePrefix + "UpdateOrganizationUserRole": {OrganizationMemberWrite, ScopeRequest},
+	// Members may always leave; removing someone else is checked by the service.
+	servicePrefix + "RemoveOrganizationUser": {OrganizationMemberRead, ScopeRequest},
+
+	servicePrefix + "CreateCustomer": {CustomerWrite, ScopeOrganization},
+	servicePrefix + "GetCustomer":    {CustomerRead, ScopeOrganization},
+	servicePrefix + "UpdateCustomer": {CustomerWrite, ScopeOrganization},
+	servicePrefix + "DeleteCustomer": {CustomerWrite, ScopeOrganization},
+	servicePrefix + "ListCustomers":  {CustomerRead, ScopeOrganization},
+
+	servicePrefix + "CreateProduct": {ProductWrite, ScopeOrganization},
+	servicePrefix + "GetProduct":    {ProductRead, ScopeOrganization},
+	servicePrefix + "UpdateProduct": {ProductWrite, ScopeOrganization},
+	servicePrefix + "DeleteProduct": {ProductWrite, ScopeOrganization},
+	servicePrefix + "ListProducts":  {ProductRead, ScopeOrganization},
+
+	servicePrefix + "CreateProductCategory": {ProductCategoryWrite, ScopeOrganization},
+	servicePrefix + "GetProductCategory":    {ProductCategoryRead, ScopeOrganization},
+	servicePrefix + "UpdateProductCategory": {ProductCategoryWrite, ScopeOrganization},
+	servicePrefix + "DeleteProductCategory": {ProductCategoryWrite, ScopeOrganization},
+	servicePrefix + "ListProductCategories": {ProductCategoryRead, ScopeOrganization},
+
+	servicePrefix + "CreateSupplier": {SupplierWrite, ScopeOrganization},
+	servicePrefix + "GetSupplier":    {SupplierRead, ScopeOrganization},
+	servicePrefix + "UpdateSupplier": {SupplierWrite, ScopeOrganization},
+	servicePrefix + "DeleteSupplier": {SupplierWrite, ScopeOrganization},
+	servicePrefix + "ListSuppliers":  {SupplierRead, ScopeOrganization},
+
+	servicePrefix + "CreateVendor": {VendorWrite, ScopeOrganization},
+	servicePrefix + "GetVendor":    {VendorRead, ScopeOrganization},
+	servicePrefix + "UpdateVendor": {VendorWrite, ScopeOrganization},
+	servicePrefix + "DeleteVendor": {VendorWrite, ScopeOrganization},
+	servicePrefix + "ListVendors":  {VendorRead, ScopeOrganization},
+}
+
+// IsPublic reports whether the method can be called without a token. Besides
+// the OAuth proxy methods these are reflection and health checks.
+func IsPublic(fullMethod string) bool {
+	return publicMethods[fullMethod] ||
+		strings.HasPrefix(fullMethod, "/grpc.reflection") ||
+		strings.HasPrefix(fullMethod, "/grpc.health.v1.Health/")
+}
+
+// RuleFor returns the access rule of the method. Methods without a rule must
+// be denied.
+func RuleFor(fullMethod string) (Rule, bool) {
+	rule, ok := methodRules[fullMethod]
+	return rule, ok
+}
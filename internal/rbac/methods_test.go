@@ -0,0 +1,43 @@
+package rbac
+
+import (
+	"testing"
+
+	adminpb "persacc/api/v1/admin"
+)
+
+func TestEveryMethodHasRule(t *testing.T) {
+	for _, m := range adminpb.AdminService_ServiceDesc.Methods {
+		fullMethod := "/" + adminpb.AdminService_ServiceDesc.ServiceName + "/" + m.MethodName
+		if IsPublic(fullMethod) {
+			continue
+		}
+		if _, ok := RuleFor(fullMethod); !ok {
+			t.Errorf("method %s has no access rule", fullMethod)
+		}
+	}
+}
+
+func TestRulePermissionsAreInCatalogue(t *testing.T) {
+	known := make(map[string]bool, len(Catalogue))
+	for _, p := range Catalogue {
+		known[p.Name] = true
+	}
+	for method, rule := range methodRules {
+		if rule.Permission != "" && !known[rule.Permission] {
+			t.Errorf("method %s requires unknown permission %q", method, rule.Permission)
+		}
+	}
+}
+
+func TestHealthIsPublic(t *testing.T) {
+	if !IsPublic("/grpc.health.v1.Health/Check") {
+		t.Fatal("health checks require a token")
+	}
+}
+
+func TestUnmappedMethodIsDenied(t *testing.T) {
+	if _, ok := RuleFor("/admin.AdminService/SomethingNew"); ok {
+		t.Fatal("unmapped method returned a rule")
+	}
+}
@@ -0,0 +1,114 @@
+package rbac
+
+import "persacc/internal/entity"
+
+// Permission names stored in the permissions table.
+const (
+	UserRead                = "user.read"
+	UserWrite               = "user.write"
+	RoleRead                = "role.read"
+	RoleWrite               = "role.write"
+	PermissionRead          = "permission.read"
+	PermissionWrite         = "permission.write"
+	OrganizationRead        = "organization.read"
+	OrganizationWrite       = "organization.write"
+	OrganizationDelete      = "organization.delete"
+	OrganizationMemberRead  = "organization_user.read"
+	OrganizationMemberWrite = "organization_user.write"
+	CustomerRead            = "customer.read"
+	CustomerWrite           = "customer.write"
+	ProductRead             = "product.read"
+	ProductWrite            = "product.write"
+	ProductCategoryRead     = "product_category.read"
+	ProductCategoryWrite    = "product_category.write"
+	SupplierRead            = "supplier.read"
+	SupplierWrite           = "supplier.write"
+	VendorRead              = "vendor.read"
+	VendorWrite             = "vendor.write"
+)
+
+// Catalogue lists every permission the API checks, with a human readable
+// description.
+var Catalogue = []entity.Permission{
+	{Name: UserRead, Description: "View users"},
+	{Name: UserWrite, Description: "Create, update and delete users"},
+	{Name: RoleRead, Description: "View roles"},
+	{Name: RoleWrite, Description: "Create, update and delete roles"},
+	{Name: PermissionRead, Description: "View permissions"},
+	{Name: PermissionWrite, Description: "Create, update and delete permissions"},
+	{Name: OrganizationRead, Description: "View organizations"},
+	{Name: OrganizationWrite, Description: "Create and update organizations"},
+	{Name: OrganizationDelete, Description: "Delete organizations"},
+	{Name: OrganizationMemberRead, Description: "View organization members"},
+	{Name: OrganizationMemberWrite, Description: "Add, update and remove organization members"},
+	{Name: CustomerRead, Description: "View customers"},
+	{Name: CustomerWrite, Description: "Create, update and delete customers"},
+	{Name: ProductRead, Description: "View products"},
+	{Name: ProductWrite, Description: "Create, update and delete products"},
+	{Name: ProductCategoryRead, Description: "View product categories"},
+	{Name: ProductCategoryWrite, Description: "Create, update and delete product categories"},
+	{Name: SupplierRead, Description: "View suppliers"},
+	{Name: SupplierWrite, Description: "Create, update and delete suppliers"},
+	{Name: VendorRead, Description: "View vendors"},
+	{Name: VendorWrite, Description: "Create, update and delete vendors"},
+}
+
+var organizationReadPermissions = []string{
+	OrganizationRead, OrganizationMemberRead,
+	CustomerRead, ProductRead, ProductCategoryRead, SupplierRead, VendorRead,
+}
+
+var organizationDataWritePermissions = []string{
+	CustomerWrite, ProductWrite, ProductCategoryWrite, SupplierWrite,
+}
+
+// organizationRolePermissions caps what each organization role may do inside
+// its organization, on top of the user's global role.
+var organizationRolePermissions = map[string][]string{
+	entity.OrganizationRoleOwner: concat(organizationReadPermissions, organizationDataWritePermissions,
+		[]string{VendorWrite, OrganizationWrite, OrganizationDelete, OrganizationMemberWrite}),
+	entity.OrganizationRoleManager: concat(organizationReadPermissions, organizationDataWritePermissions,
+		[]string{VendorWrite, OrganizationWrite, OrganizationMemberWrite}),
+	entity.OrganizationRoleAccountant: concat(organizationReadPermissions, organizationDataWritePermissions),
+	entity.OrganizationRoleViewer:     organizationReadPermissions,
+}
+
+// OrganizationRoleAllows reports whether the organization role grants permission.
+func OrganizationRoleAllows(role, permission string) bool {
+	for _, p := range organizationRolePermissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+func concat(lists ...[]string) []string {
+	var out []string
+	for _, l := range lists {
+		out = append(out, l...)
+	}
+	return out
+}
+
+// Global roles created by the bootstrap.
+const (
+	RoleAdmin = "admin"
+	RoleUser  = "user"
+)
+
+// DefaultRolePermissions is the permission set each bootstrap role starts
+// with. The admin role receives every permission in Catalogue.
+var DefaultRolePermissions = map[string][]string{
+	RoleAdmin: catalogueNames(),
+	RoleUser: concat(organizationReadPermissions, organizationDataWritePermissions,
+		[]string{OrganizationWrite, OrganizationDelete, OrganizationMemberWrite}),
+}
+
+func catalogueNames() []string {
+	names := make([]string, 0, len(Catalogue))
+	for _, p := range Catalogue {
+		names = append(names, p.Name)
+	}
+	return names
+}
@@ -0,0 +1,20 @@
+package rbac
+
+import "testing"
+
+func TestDefaultRolePermissionsAreInCatalogue(t *testing.T) {
+	known := make(map[string]bool, len(Catalogue))
+	for _, p := range Catalogue {
+		known[p.Name] = true
+	}
+	for role, perms := range DefaultRolePermissions {
+		for _, p := range perms {
+			if !known[p] {
+				t.Errorf("role %s has unknown permission %q", role, p)
+			}
+		}
+	}
+	if len(DefaultRolePermissions[RoleAdmin]) != len(Catalogue) {
+		t.Errorf("admin role has %d permissions, want all %d", len(DefaultRolePermissions[RoleAdmin]), len(Catalogue))
+	}
+}
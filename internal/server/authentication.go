@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"persacc/internal/authn"
+	"persacc/internal/principal"
+	"persacc/internal/rbac"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Authenticator verifies the bearer token of every non-public method,
+// reusing earlier verifications of the same token from the token cache.
+type Authenticator struct {
+	Verifier authn.Verifier
+	Tokens   *TokenCache
+}
+
+func NewAuthenticator(verifier authn.Verifier, tokens *TokenCache) *Authenticator {
+	return &Authenticator{
+		Verifier: verifier,
+		Tokens:   tokens,
+	}
+}
+
+func (a *Authenticator) Unary() grpc.UnaryServerInterceptor {
+	return unaryStage(a.authenticate)
+}
+
+func (a *Authenticator) Stream() grpc.StreamServerInterceptor {
+	return streamStage(a.authenticate)
+}
+
+func (a *Authenticator) authenticate(ctx context.Context, method string, req interface{}) (context.Context, error) {
+	// Skip auth for reflection and OAuth proxy methods
+	if rbac.IsPublic(method) {
+		return ctx, nil
+	}
+	slog.DebugContext(ctx, "authenticating request", "method", method)
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "metadata is not provided")
+	}
+
+	values := md["authorization"]
+	if len(values) == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "authorization token is not provided")
+	}
+
+	accessToken := values[0]
+	accessToken = strings.TrimPrefix(accessToken, "Bearer ")
+
+	// Reuse a previous verification of the same token if it is cached,
+	// otherwise verify it locally or through OAuth Verify
+	entry, cached := a.Tokens.get(accessToken)
+	if !cached {
+		verifyCtx, span := tracer.Start(ctx, "auth.VerifyToken")
+		identity, err := a.Verifier.Verify(verifyCtx, accessToken)
+		endSpan(span, err)
+		if err != nil {
+			if errors.Is(err, authn.ErrInvalidToken) {
+				return nil, status.Errorf(codes.Unauthenticated, "token is invalid")
+			}
+			return nil, status.Errorf(codes.Unauthenticated, "failed to verify token: %v", err)
+		}
+
+		entry = cachedToken{
+			Email: identity.Email,
+			Name:  identity.Name,
+			Uuid:  identity.Uuid,
+			Exp:   identity.Exp,
+		}
+	}
+
+	ctx = principal.NewContext(ctx, &principal.Principal{
+		Claims: principal.Claims{
+			Email: entry.Email,
+			Name:  entry.Name,
+			Uuid:  entry.Uuid,
+			Exp:   entry.Exp,
+		},
+	})
+	return context.WithValue(ctx, authenticatedKey{}, authenticated{
+		token:  accessToken,
+		entry:  entry,
+		cached: cached,
+	}), nil
+}
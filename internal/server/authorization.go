@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+
+	"persacc/internal/rbac"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Authorizer checks the permission a method requires against the global
+// role of the caller. Methods without a rule are denied.
+type Authorizer struct{}
+
+func NewAuthorizer() *Authorizer {
+	return &Authorizer{}
+}
+
+func (a *Authorizer) Unary() grpc.UnaryServerInterceptor {
+	return unaryStage(a.authorize)
+}
+
+func (a *Authorizer) Stream() grpc.StreamServerInterceptor {
+	return streamStage(a.authorize)
+}
+
+func (a *Authorizer) authorize(ctx context.Context, method string, req interface{}) (context.Context, error) {
+	if rbac.IsPublic(method) {
+		return ctx, nil
+	}
+
+	// Deny by default: every method must declare the permission it needs
+	rule, ok := rbac.RuleFor(method)
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "access denied: method %s is not mapped to a permission", method)
+	}
+	p, err := principalFrom(ctx, rule.Permission != "")
+	if err != nil {
+		return nil, err
+	}
+	if rule.Permission != "" && !p.HasPermission(rule.Permission) {
+		return nil, status.Errorf(codes.PermissionDenied, "access denied: method requires %q permission", rule.Permission)
+	}
+	return ctx, nil
+}
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"persacc/internal/data"
+	"persacc/internal/fieldmask"
+	"persacc/internal/listquery"
+	"persacc/internal/pagination"
+	"persacc/internal/service"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"gorm.io/gorm"
+)
+
+// ErrorUnaryInterceptor translates the errors handlers return into gRPC
+// statuses. It runs last in the chain so that logging and metrics record the
+// translated code.
+func ErrorUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, statusError(ctx, info.FullMethod, err)
+		}
+		return resp, nil
+	}
+}
+
+func ErrorStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return statusError(ss.Context(), info.FullMethod, err)
+		}
+		return nil
+	}
+}
+
+// statusError maps the domain errors of the service layer to their codes,
+// with details naming the offending field or resource. Statuses pass
+// through; any other error is logged and reported as Internal without its
+// message, which may contain SQL.
+func statusError(ctx context.Context, method string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var (
+		notFound   *service.NotFoundError
+		conflict   *service.ConflictError
+		validation *service.ValidationError
+		foreignKey *service.ForeignKeyError
+		queryErr   *listquery.Error
+		maskErr    *fieldmask.Error
+	)
+	switch {
+	case errors.As(err, &notFound):
+		return withDetails(codes.NotFound, err.Error(), &errdetails.ResourceInfo{
+			ResourceType: notFound.Resource,
+			ResourceName: notFound.ID,
+			Description:  err.Error(),
+		})
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, "not found")
+	case errors.As(err, &conflict):
+		name := conflict.ID
+		if name == "" {
+			name = conflict.Value
+		}
+		return withDetails(codes.AlreadyExists, err.Error(), &errdetails.ResourceInfo{
+			ResourceType: conflict.Resource,
+			ResourceName: name,
+			Description:  err.Error(),
+		})
+	case errors.As(err, &validation):
+		br := &errdetails.BadRequest{}
+		for _, v := range validation.Violations {
+			br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+		return withDetails(codes.InvalidArgument, err.Error(), br)
+	case errors.As(err, &foreignKey):
+		return withDetails(codes.FailedPrecondition, err.Error(), badRequest(foreignKey.Field, err.Error()))
+	case errors.Is(err, data.ErrStaleVersion):
+		return status.Error(codes.Aborted, "the row was modified by another request, read it again and retry")
+	case errors.Is(err, pagination.ErrInvalidToken):
+		return withDetails(codes.InvalidArgument, err.Error(), badRequest("page_token", err.Error()))
+	case errors.As(err, &queryErr):
+		return withDetails(codes.InvalidArgument, err.Error(), badRequest(queryErr.Param, queryErr.Reason))
+	case errors.As(err, &maskErr):
+		return withDetails(codes.InvalidArgument, "invalid update_mask: "+err.Error(), badRequest("update_mask", err.Error()))
+	case errors.Is(err, service.ErrOrganizationAccessDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrOrganizationUserExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrOrganizationOwner), errors.Is(err, service.ErrCustomerShared), errors.Is(err, service.ErrDefaultRoleMissing):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	slog.ErrorContext(ctx, "unhandled error", slog.String("method", method), slog.String("error", err.Error()))
+	return status.Error(codes.Internal, "internal error")
+}
+
+func badRequest(field, description string) *errdetails.BadRequest {
+	return &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}},
+	}
+}
+
+// withDetails returns a status of code with detail attached, or without it
+// if the detail cannot be encoded.
+func withDetails(code codes.Code, msg string, detail protoadapt.MessageV1) error {
+	st := status.New(code, msg)
+	if withDetail, err := st.WithDetails(detail); err == nil {
+		return withDetail.Err()
+	}
+	return st.Err()
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"persacc/internal/data"
+	"persacc/internal/listquery"
+	"persacc/internal/service"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStatusError(t *testing.T) {
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{&service.NotFoundError{Resource: "product", ID: "7"}, codes.NotFound},
+		{&service.ConflictError{Resource: "product", Field: "sku", Value: "CH-1"}, codes.AlreadyExists},
+		{service.NewValidationError("name", "is required"), codes.InvalidArgument},
+		{&service.ForeignKeyError{Field: "category_id", Value: "3", Table: "product_categories"}, codes.FailedPrecondition},
+		{fmt.Errorf("update: %w", data.ErrStaleVersion), codes.Aborted},
+		{service.ErrDefaultRoleMissing, codes.FailedPrecondition},
+		{&listquery.Error{Param: "filter", Reason: "unknown field"}, codes.InvalidArgument},
+		{status.Error(codes.PermissionDenied, "denied"), codes.PermissionDenied},
+		{context.DeadlineExceeded, codes.DeadlineExceeded},
+		{errors.New(`ERROR: relation "products" does not exist`), codes.Internal},
+	}
+	for _, tt := range tests {
+		if got := status.Code(statusError(context.Background(), "/test", tt.err)); got != tt.code {
+			t.Errorf("statusError(%v) = %v, want %v", tt.err, got, tt.code)
+		}
+	}
+}
+
+func TestStatusErrorDetails(t *testing.T) {
+	st := status.Convert(statusError(context.Background(), "/test", service.NewValidationError("email", "is not an email address")))
+	br, ok := st.Details()[0].(*errdetails.BadRequest)
+	if !ok || br.FieldViolations[0].Field != "email" {
+		t.Errorf("details = %v, want a BadRequest for email", st.Details())
+	}
+
+	st = status.Convert(statusError(context.Background(), "/test", &service.NotFoundError{Resource: "vendor", ID: "4"}))
+	info, ok := st.Details()[0].(*errdetails.ResourceInfo)
+	if !ok || info.ResourceType != "vendor" || info.ResourceName != "4" {
+		t.Errorf("details = %v, want a ResourceInfo for vendor 4", st.Details())
+	}
+
+	st = status.Convert(statusError(context.Background(), "/test", errors.New("pq: syntax error at SELECT")))
+	if st.Message() != "internal error" {
+		t.Errorf("message = %q leaks the error", st.Message())
+	}
+}
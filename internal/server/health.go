@@ -0,0 +1,263 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	adminpb "persacc/api/v1/admin"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// ErrDraining is returned by Drain for requests still running at its
+// deadline.
+var ErrDraining = errors.New("requests still in flight")
+
+// Health reports liveness and readiness over HTTP and grpc.health.v1, and
+// drains requests on shutdown. The server is ready while Postgres and the
+// OAuth service are reachable and it is not shutting down.
+type Health struct {
+	DB       *gorm.DB
+	AuthConn grpc.ClientConnInterface
+	// Timeout bounds each dependency check.
+	Timeout time.Duration
+
+	grpcHealth *health.Server
+
+	mu       sync.Mutex
+	draining bool
+	active   int
+	idle     chan struct{}
+}
+
+func NewHealth(db *gorm.DB, authConn grpc.ClientConnInterface) *Health {
+	return &Health{
+		DB:         db,
+		AuthConn:   authConn,
+		Timeout:    2 * time.Second,
+		grpcHealth: health.NewServer(),
+	}
+}
+
+// GRPC returns the grpc.health.v1 service to register on the server.
+func (h *Health) GRPC() healthpb.HealthServer {
+	return h.grpcHealth
+}
+
+// checkResult is the outcome of one dependency check. Error is only
+// logged: it may name hosts and addresses, and /readyz is public.
+type checkResult struct {
+	Name  string `json:"name"`
+	OK    bool   `json:"ok"`
+	Error string `json:"-"`
+}
+
+// Check probes the dependencies the server needs to serve requests.
+func (h *Health) Check(ctx context.Context) []checkResult {
+	checks := []struct {
+		name  string
+		check func(context.Context) error
+	}{
+		{"postgres", h.checkDB},
+		{"oauth", h.checkAuth},
+	}
+
+	results := make([]checkResult, len(checks))
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, h.Timeout)
+			defer cancel()
+			results[i] = checkResult{Name: c.name, OK: true}
+			if err := c.check(ctx); err != nil {
+				results[i] = checkResult{Name: c.name, Error: err.Error()}
+			}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+func (h *Health) checkDB(ctx context.Context) error {
+	sqlDB, err := h.DB.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+// checkAuth asks the OAuth service for its health. A service that does not
+// implement grpc.health.v1 still answered, so it counts as reachable.
+func (h *Health) checkAuth(ctx context.Context) error {
+	resp, err := healthpb.NewHealthClient(h.AuthConn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return nil
+		}
+		return err
+	}
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("status %s", resp.Status)
+	}
+	return nil
+}
+
+// Watch refreshes the grpc.health.v1 status from the dependency checks
+// every interval until ctx is done or the server starts draining.
+func (h *Health) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		h.update(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *Health) update(ctx context.Context) {
+	if h.isDraining() {
+		return
+	}
+	st := healthpb.HealthCheckResponse_SERVING
+	for _, r := range h.Check(ctx) {
+		if !r.OK {
+			slog.WarnContext(ctx, "dependency check failed", "dependency", r.Name, "error", r.Error)
+			st = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+	}
+	h.grpcHealth.SetServingStatus("", st)
+	h.grpcHealth.SetServingStatus(adminpb.AdminService_ServiceDesc.ServiceName, st)
+}
+
+// Liveness answers /healthz: the process is up and serving HTTP.
+func (h *Health) Liveness() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Write([]byte("ok\n"))
+	})
+}
+
+// Readiness answers /readyz with whether every dependency check passed,
+// failing with 503 while any check fails or the server is draining. Why a
+// check failed is logged, not returned.
+func (h *Health) Readiness() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := struct {
+			Ready    bool          `json:"ready"`
+			Draining bool          `json:"draining,omitempty"`
+			Checks   []checkResult `json:"checks"`
+		}{
+			Ready:    true,
+			Draining: h.isDraining(),
+			Checks:   h.Check(r.Context()),
+		}
+		if resp.Draining {
+			resp.Ready = false
+		}
+		for _, c := range resp.Checks {
+			if !c.OK {
+				slog.WarnContext(r.Context(), "dependency check failed", "dependency", c.Name, "error", c.Error)
+				resp.Ready = false
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if !resp.Ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+}
+
+// Middleware counts the requests in flight so that Drain can wait for them,
+// and turns requests away once draining has started. gRPC clients see
+// UNAVAILABLE and retry on another replica.
+func (h *Health) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Health watches stay open until the server stops and must not hold
+		// up draining
+		if strings.HasPrefix(r.URL.Path, "/grpc.health.v1.Health/") {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if !h.begin() {
+			w.Header().Set("Connection", "close")
+			w.Header().Set("Grpc-Status", fmt.Sprint(int(codes.Unavailable)))
+			w.Header().Set("Grpc-Message", "server is shutting down")
+			http.Error(w, "server is shutting down", http.StatusServiceUnavailable)
+			return
+		}
+		defer h.end()
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (h *Health) begin() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.draining {
+		return false
+	}
+	h.active++
+	return true
+}
+
+func (h *Health) end() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.active--
+	if h.active == 0 && h.idle != nil {
+		close(h.idle)
+		h.idle = nil
+	}
+}
+
+func (h *Health) isDraining() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.draining
+}
+
+// Drain marks the server as not ready, stops accepting requests and waits
+// until the requests in flight finish or ctx is done.
+func (h *Health) Drain(ctx context.Context) error {
+	h.grpcHealth.Shutdown()
+
+	h.mu.Lock()
+	h.draining = true
+	if h.active == 0 {
+		h.mu.Unlock()
+		return nil
+	}
+	if h.idle == nil {
+		h.idle = make(chan struct{})
+	}
+	idle := h.idle
+	active := h.active
+	h.mu.Unlock()
+
+	slog.Info("draining requests", "in_flight", active)
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ErrDraining
+	}
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"persacc/internal/data/datatest"
+
+	"google.golang.org/grpc"
+)
+
+func TestHealthDrain(t *testing.T) {
+	h := NewHealth(nil, nil)
+	release := make(chan struct{})
+	started := make(chan struct{})
+	handler := h.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+	}))
+
+	go handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("POST", "/admin.AdminService/ListUsers", nil))
+	<-started
+
+	drained := make(chan error, 1)
+	go func() {
+		drained <- h.Drain(context.Background())
+	}()
+
+	// New requests are turned away while the first one is still running
+	deadline := time.Now().Add(time.Second)
+	for !h.isDraining() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("POST", "/admin.AdminService/ListUsers", nil))
+	if rec.Code != http.StatusServiceUnavailable || rec.Header().Get("Grpc-Status") != "14" {
+		t.Fatalf("request during drain got %d, grpc-status %q", rec.Code, rec.Header().Get("Grpc-Status"))
+	}
+
+	select {
+	case err := <-drained:
+		t.Fatalf("drain finished with a request in flight: %v", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	close(release)
+	select {
+	case err := <-drained:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("drain did not finish after the request completed")
+	}
+}
+
+func TestHealthDrainDeadline(t *testing.T) {
+	h := NewHealth(nil, nil)
+	if !h.begin() {
+		t.Fatal("request rejected before draining")
+	}
+	defer h.end()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := h.Drain(ctx); err != ErrDraining {
+		t.Fatalf("got %v, want ErrDraining", err)
+	}
+}
+
+// failingConn fails every call with an error naming its address.
+type failingConn struct{ grpc.ClientConnInterface }
+
+func (failingConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	return errors.New("dial tcp 10.0.0.7:9000: connection refused")
+}
+
+func TestReadinessHidesCheckErrors(t *testing.T) {
+	db, _ := datatest.DryRun(t)
+	h := NewHealth(db, failingConn{})
+	rec := httptest.NewRecorder()
+	h.Readiness().ServeHTTP(rec, httptest.NewRequest("GET", "/readyz", nil))
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want 503", rec.Code)
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, `"name":"oauth","ok":false`) {
+		t.Errorf("body %s does not report the failed check", body)
+	}
+	for _, leak := range []string{"10.0.0.7", "127.0.0.1", "error"} {
+		if strings.Contains(body, leak) {
+			t.Errorf("body %s contains %q", body, leak)
+		}
+	}
+}
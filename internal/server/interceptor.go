@@ -2,196 +2,123 @@ package server
 
 import (
 	"context"
-	"errors"
-	"log"
-	"strconv"
-	"strings"
 
-	"persacc/internal/entity"
+	adminpb "persacc/api/v1/admin"
+	"persacc/internal/principal"
 
-	oauthpb "github.com/gevorgmb/oauth/api/v1/pb/proto"
-	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
-	"gorm.io/gorm"
 )
 
-type AuthInterceptor struct {
-	DB         *gorm.DB
-	AuthClient oauthpb.OAuthClient
-}
-
-func NewAuthInterceptor(db *gorm.DB, authClient oauthpb.OAuthClient) *AuthInterceptor {
-	return &AuthInterceptor{
-		DB:         db,
-		AuthClient: authClient,
-	}
-}
-
-func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
+// The access checks run as a chain of interceptors, each doing one step and
+// adding its result to the principal.Principal of the context:
+//
+//	Authenticator  verifies the bearer token and sets the token claims
+//	UserSync       loads (or creates) the local user of the token
+//	Authorizer     checks the method's permission against the user's role
+//	Tenancy        resolves the organization and the user's role in it
+//
+// A step that does not find the result of an earlier one fails closed, so a
+// misordered chain denies requests instead of letting them through.
+
+// registerMethod is open to any verified token, including tokens of users
+// that do not exist locally yet.
+const registerMethod = "/admin.AdminService/Register"
+
+// stage is a single access check. It returns the context the rest of the
+// chain runs with; req is nil for streams.
+type stage func(ctx context.Context, method string, req interface{}) (context.Context, error)
+
+func unaryStage(s stage) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		// Log the method being called for debugging
-		log.Printf("Checking access for method: %s", info.FullMethod)
-
-		// Skip auth for reflection and OAuth proxy methods
-		publicMethods := map[string]bool{
-			"/admin.AdminService/OAuthRegister": true,
-			"/admin.AdminService/OAuthToken":    true,
-			"/admin.AdminService/OAuthVerify":   true,
-			"/admin.AdminService/OAuthRefresh":  true,
-		}
-
-		if strings.HasPrefix(info.FullMethod, "/grpc.reflection") || publicMethods[info.FullMethod] {
-			return handler(ctx, req)
-		}
-
-		// 1. Extract Authorization from metadata
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Errorf(codes.Unauthenticated, "metadata is not provided")
-		}
-		log.Println("Metadata is provided:")
-		for k, v := range md {
-			log.Printf("  Metadata: %q: %v", k, v)
+		ctx, err := s(ctx, info.FullMethod, req)
+		if err != nil {
+			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		values := md["authorization"]
-		if len(values) == 0 {
-			return nil, status.Errorf(codes.Unauthenticated, "authorization token is not provided")
+func streamStage(s stage) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := s(ss.Context(), info.FullMethod, nil)
+		if err != nil {
+			return err
 		}
-		log.Println("Authorization token is provided")
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
 
-		accessToken := values[0]
-		accessToken = strings.TrimPrefix(accessToken, "Bearer ")
+// serverStream overrides the context of a stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
 
-		// 2. Call OAuth Verify
-		verifyResp, err := i.AuthClient.Verify(ctx, &oauthpb.VerifyRequest{
-			AccessToken: accessToken,
-		})
-		if err != nil {
-			return nil, status.Errorf(codes.Unauthenticated, "failed to verify token: %v", err)
-		}
-		log.Println("Token is valid")
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
 
-		if !verifyResp.Valid {
-			return nil, status.Errorf(codes.Unauthenticated, "token is invalid")
-		}
-		log.Println("Token is valid")
-
-		// 3. Skip DB check if this is the Register method
-		if info.FullMethod == "/admin.AdminService/Register" {
-			// Pass email and name in context to the Register handler
-			ctx = context.WithValue(ctx, "email", verifyResp.Email)
-			ctx = context.WithValue(ctx, "name", verifyResp.Name)
-			return handler(ctx, req)
-		}
+var tracer = otel.Tracer("persacc/internal/server")
 
-		// 4. User is authenticated, now sync with local DB
-		userEmail := verifyResp.Email
-		userUuid := verifyResp.GetUuid()
-		var user entity.User
-
-		foundByUuid := false
-		if userUuid != "" {
-			if err := i.DB.Preload("Role").First(&user, "uuid = ?", userUuid).Error; err == nil {
-				foundByUuid = true
-			} else if !errors.Is(err, gorm.ErrRecordNotFound) {
-				return nil, status.Errorf(codes.Internal, "failed to check user by uuid: %v", err)
-			}
-		}
+// endSpan ends a span of an access check, recording its error.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	}
+	span.End()
+}
 
-		if !foundByUuid {
-			if err := i.DB.Preload("Role").First(&user, "email = ?", userEmail).Error; err != nil {
-				if errors.Is(err, gorm.ErrRecordNotFound) {
-					// User not found, create a new one
-					user = entity.User{
-						Name:   verifyResp.Name,
-						Email:  userEmail,
-						Uuid:   userUuid,
-						RoleID: 1, // Default role
-					}
-					if err := i.DB.Create(&user).Error; err != nil {
-						return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
-					}
-					// Reload to get the Role for the role checks below
-					if err := i.DB.Preload("Role").First(&user, user.ID).Error; err != nil {
-						return nil, status.Errorf(codes.Internal, "failed to load created user: %v", err)
-					}
-				} else {
-					return nil, status.Errorf(codes.Internal, "failed to check user by email: %v", err)
-				}
-			} else if user.Uuid == "" && userUuid != "" {
-				// User found by email but missing UUID, update it
-				user.Uuid = userUuid
-				if err := i.DB.Save(&user).Error; err != nil {
-					return nil, status.Errorf(codes.Internal, "failed to update user uuid: %v", err)
-				}
-			}
-		}
+type authenticatedKey struct{}
 
-		// 5. Define paths that explicitly require admin access
-		requireAdminPaths := map[string]bool{
-			"/admin.AdminService/CreateUser":       true,
-			"/admin.AdminService/UpdateUser":       true,
-			"/admin.AdminService/DeleteUser":       true,
-			"/admin.AdminService/CreateRole":       true,
-			"/admin.AdminService/UpdateRole":       true,
-			"/admin.AdminService/DeleteRole":       true,
-			"/admin.AdminService/CreatePermission": true,
-			"/admin.AdminService/UpdatePermission": true,
-			"/admin.AdminService/DeletePermission": true,
-		}
+// authenticated is the token the Authenticator verified, kept for the user
+// sync which caches the token with its user.
+type authenticated struct {
+	token string
+	entry cachedToken
+	// cached is set when entry came from the token cache and already holds
+	// the synced user.
+	cached bool
+}
 
-		// 6. Check if this method requires admin and if the user is an admin
-		if requireAdminPaths[info.FullMethod] {
-			if user.Role.Name != "admin" {
-				return nil, status.Errorf(codes.PermissionDenied, "access denied: method requires admin role")
-			}
-		}
+func authenticatedFrom(ctx context.Context) (authenticated, error) {
+	a, ok := ctx.Value(authenticatedKey{}).(authenticated)
+	if !ok {
+		return a, status.Errorf(codes.Unauthenticated, "request is not authenticated")
+	}
+	return a, nil
+}
 
-		// 8. Enforce Organization ID for all requests except exempted ones
-		isExempted := strings.Contains(info.FullMethod, "OAuth") ||
-			strings.Contains(info.FullMethod, "Organization") ||
-			info.FullMethod == "/admin.AdminService/Register" ||
-			strings.HasPrefix(info.FullMethod, "/grpc.reflection")
-
-		if !isExempted {
-			orgId := ""
-			if vals := md.Get("organization_id"); len(vals) > 0 {
-				orgId = vals[0]
-			}
-			if orgId == "" {
-				if vals := md.Get("organization-id"); len(vals) > 0 {
-					orgId = vals[0]
-				}
-			}
-
-			if strings.TrimSpace(orgId) == "" {
-				st := status.New(codes.InvalidArgument, "missing organization_id header")
-				v := &errdetails.BadRequest_FieldViolation{
-					Field:       "organization_id",
-					Description: "The organization_id header is required for this request",
-				}
-				br := &errdetails.BadRequest{}
-				br.FieldViolations = append(br.FieldViolations, v)
-				st, err := st.WithDetails(br)
-				if err != nil {
-					return nil, status.Errorf(codes.Internal, "failed to attach error details: %v", err)
-				}
-				return nil, st.Err()
-			}
-
-			orgIDInt, err := strconv.ParseInt(orgId, 10, 64)
-			if err != nil {
-				return nil, status.Errorf(codes.InvalidArgument, "invalid organization_id header: %v", err)
-			}
-			ctx = context.WithValue(ctx, "organization_id", orgIDInt)
-		}
+// principalFrom returns a copy of the context's principal for a stage to
+// extend. It fails if an earlier stage did not run.
+func principalFrom(ctx context.Context, needUser bool) (principal.Principal, error) {
+	p, err := principal.FromContext(ctx)
+	if err != nil {
+		return principal.Principal{}, status.Errorf(codes.Unauthenticated, "%v", err)
+	}
+	if needUser && p.User.ID == 0 {
+		return principal.Principal{}, status.Errorf(codes.Unauthenticated, "%v", principal.ErrNoUser)
+	}
+	return *p, nil
+}
 
-		// 9. Proceed
-		ctx = context.WithValue(ctx, "user_id", user.ID)
-		return handler(ctx, req)
+// requestOrganizationID returns the organization named in the body of a
+// request whose rule has rbac.ScopeRequest.
+func requestOrganizationID(req interface{}) int64 {
+	switch r := req.(type) {
+	case *adminpb.GetOrganizationRequest:
+		return r.Id
+	case *adminpb.UpdateOrganizationRequest:
+		return r.Id
+	case *adminpb.DeleteOrganizationRequest:
+		return r.Id
+	case interface{ GetOrganizationId() int64 }:
+		return r.GetOrganizationId()
 	}
+	return 0
 }
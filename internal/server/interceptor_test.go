@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"persacc/internal/authn"
+	"persacc/internal/entity"
+	"persacc/internal/principal"
+	"persacc/internal/rbac"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type stubVerifier struct {
+	calls int
+}
+
+func (v *stubVerifier) Verify(ctx context.Context, accessToken string) (*authn.Identity, error) {
+	v.calls++
+	if accessToken != "good" {
+		return nil, authn.ErrInvalidToken
+	}
+	return &authn.Identity{Email: "a@example.com", Name: "A", Exp: time.Now().Add(time.Hour).Unix()}, nil
+}
+
+type stubStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *stubStream) Context() context.Context { return s.ctx }
+
+// chain runs the unary access checks in their production order. The user
+// sync stage has no database, so tests preload the token cache.
+func chain(tokens *TokenCache, verifier authn.Verifier) grpc.UnaryServerInterceptor {
+	stages := []grpc.UnaryServerInterceptor{
+		NewAuthenticator(verifier, tokens).Unary(),
+		NewUserSync(nil, tokens).Unary(),
+		NewAuthorizer().Unary(),
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		next := handler
+		for i := len(stages) - 1; i >= 0; i-- {
+			s, h := stages[i], next
+			next = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return s(ctx, req, info, h)
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+func withToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestInterceptorChain(t *testing.T) {
+	tokens := NewTokenCache(time.Minute, 10)
+	reader := entity.User{ID: 7, Role: entity.Role{Permissions: []entity.Permission{{Name: rbac.UserRead}}}}
+	tokens.set("cached", cachedToken{Email: "r@example.com", Exp: time.Now().Add(time.Hour).Unix(), User: reader})
+	verifier := &stubVerifier{}
+	intercept := chain(tokens, verifier)
+
+	var gotCtx context.Context
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		gotCtx = ctx
+		return "ok", nil
+	}
+	call := func(ctx context.Context, method string) error {
+		gotCtx = nil
+		_, err := intercept(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		want   codes.Code
+	}{
+		{"public method needs no token", context.Background(), "/admin.AdminService/OAuthToken", codes.OK},
+		{"missing token", context.Background(), "/admin.AdminService/ListUsers", codes.Unauthenticated},
+		{"invalid token", withToken("bad"), "/admin.AdminService/ListUsers", codes.Unauthenticated},
+		{"permission granted", withToken("cached"), "/admin.AdminService/ListUsers", codes.OK},
+		{"permission missing", withToken("cached"), "/admin.AdminService/CreateUser", codes.PermissionDenied},
+		{"unmapped method", withToken("cached"), "/admin.AdminService/Unknown", codes.PermissionDenied},
+		{"register needs only a token", withToken("good"), registerMethod, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := status.Code(call(tt.ctx, tt.method)); got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if err := call(withToken("cached"), "/admin.AdminService/ListUsers"); err != nil {
+		t.Fatal(err)
+	}
+	if id, err := principal.UserID(gotCtx); err != nil || id != reader.ID {
+		t.Errorf("UserID = %d, %v, want %d", id, err, reader.ID)
+	}
+
+	if err := call(withToken("good"), registerMethod); err != nil {
+		t.Fatal(err)
+	}
+	if claims, err := principal.TokenClaims(gotCtx); err != nil || claims.Email != "a@example.com" {
+		t.Errorf("TokenClaims = %+v, %v, want email a@example.com", claims, err)
+	}
+	if _, err := principal.UserID(gotCtx); !errors.Is(err, principal.ErrNoUser) {
+		t.Errorf("UserID on Register = %v, want ErrNoUser", err)
+	}
+}
+
+func TestAuthorizerFailsClosedWithoutUser(t *testing.T) {
+	_, err := NewAuthorizer().Unary()(withToken("cached"), nil,
+		&grpc.UnaryServerInfo{FullMethod: "/admin.AdminService/ListUsers"},
+		func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil })
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("got %v, want Unauthenticated", err)
+	}
+}
+
+func TestAuthenticatorStream(t *testing.T) {
+	a := NewAuthenticator(&stubVerifier{}, NewTokenCache(time.Minute, 10))
+	ss := &stubStream{ctx: withToken("good")}
+	err := a.Stream()(nil, ss, &grpc.StreamServerInfo{FullMethod: "/admin.AdminService/ListUsers"},
+		func(srv interface{}, stream grpc.ServerStream) error {
+			auth, err := authenticatedFrom(stream.Context())
+			if err != nil {
+				return err
+			}
+			if auth.entry.Email != "a@example.com" {
+				t.Errorf("email = %q, want a@example.com", auth.entry.Email)
+			}
+			return nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"persacc/internal/logging"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// LoggingUnaryInterceptor logs every RPC with its method, status code and
+// latency. Requests that did not pass the HTTP middleware get their request
+// ID from the x-request-id metadata or a new one.
+func LoggingUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		ctx = ensureRequestID(ctx)
+
+		resp, err := handler(ctx, req)
+		logRPC(ctx, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// LoggingStreamInterceptor logs every stream once it ends, like
+// LoggingUnaryInterceptor.
+func LoggingStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		ctx := ensureRequestID(ss.Context())
+
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+		logRPC(ctx, info.FullMethod, start, err)
+		return err
+	}
+}
+
+func logRPC(ctx context.Context, method string, start time.Time, err error) {
+	st := status.Convert(err)
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("code", st.Code().String()),
+		slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", st.Message()))
+	}
+	slog.LogAttrs(ctx, codeLevel(st.Code()), "rpc", attrs...)
+}
+
+func ensureRequestID(ctx context.Context) context.Context {
+	if logging.RequestID(ctx) != "" {
+		return ctx
+	}
+	id := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get("x-request-id"); len(vals) > 0 && len(vals[0]) <= 128 {
+			id = vals[0]
+		}
+	}
+	if id == "" {
+		id = logging.NewRequestID()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-request-id", id))
+	return logging.WithRequestID(ctx, id)
+}
+
+// codeLevel logs failures caused by the caller as warnings and failures of
+// the server or its dependencies as errors.
+func codeLevel(code codes.Code) slog.Level {
+	switch code {
+	case codes.OK:
+		return slog.LevelInfo
+	case codes.Unknown, codes.DeadlineExceeded, codes.Unimplemented, codes.Internal,
+		codes.Unavailable, codes.DataLoss:
+		return slog.LevelError
+	}
+	return slog.LevelWarn
+}
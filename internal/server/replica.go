@@ -0,0 +1,34 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"persacc/internal/data"
+
+	"google.golang.org/grpc"
+)
+
+// ReplicaUnaryInterceptor lets the read-only Get and List RPCs read from
+// the replica. Every other RPC, including the reads an Update makes before
+// writing, stays on the primary.
+func ReplicaUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return unaryStage(allowReplica)
+}
+
+func ReplicaStreamInterceptor() grpc.StreamServerInterceptor {
+	return streamStage(allowReplica)
+}
+
+func allowReplica(ctx context.Context, method string, req interface{}) (context.Context, error) {
+	if isReadOnly(method) {
+		return data.AllowReplica(ctx), nil
+	}
+	return ctx, nil
+}
+
+// isReadOnly reports whether method, a full gRPC method name, only reads.
+func isReadOnly(method string) bool {
+	name := method[strings.LastIndex(method, "/")+1:]
+	return strings.HasPrefix(name, "Get") || strings.HasPrefix(name, "List")
+}
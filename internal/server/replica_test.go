@@ -0,0 +1,18 @@
+package server
+
+import "testing"
+
+func TestIsReadOnly(t *testing.T) {
+	tests := map[string]bool{
+		"/admin.AdminService/GetProduct":            true,
+		"/admin.AdminService/ListOrganizationUsers": true,
+		"/admin.AdminService/UpdateProduct":         false,
+		"/admin.AdminService/Register":              false,
+		"/grpc.health.v1.Health/Check":              false,
+	}
+	for method, want := range tests {
+		if got := isReadOnly(method); got != want {
+			t.Errorf("isReadOnly(%q) = %v, want %v", method, got, want)
+		}
+	}
+}
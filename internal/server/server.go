@@ -22,17 +22,18 @@ type AdminServer struct {
 	PermissionCtrl   *controller.PermissionController
 	OAuthCtrl        *controller.OAuthController
 	OrganizationCtrl *controller.OrganizationController
+	OrganizationUserCtrl *controller.OrganizationUserController
 	ProductCtrl      *controller.ProductController
 	ProductCategoryCtrl *controller.ProductCategoryController
 	SupplierCtrl     *controller.SupplierController
 	VendorCtrl       *controller.VendorController
 }
 
-func NewAdminServer(db *gorm.DB, authClient authpb.OAuthClient) *AdminServer {
-	userService := service.NewUserService(db)
-	roleService := service.NewRoleService(db)
-	customerService := service.NewCustomerService(db)
-	permissionService := service.NewPermissionService(db)
+func NewAdminServer(db *gorm.DB, authClient authpb.OAuthClient, orgAccess *service.OrganizationAccessService, authCache service.AuthCacheInvalidator) *AdminServer {
+	userService := service.NewUserService(db, authCache)
+	roleService := service.NewRoleService(db, authCache)
+	customerService := service.NewCustomerService(db, orgAccess)
+	permissionService := service.NewPermissionService(db, authCache)
 	oauthService := service.NewOAuthService(authClient)
 
 	return &AdminServer{
@@ -43,7 +44,8 @@ func NewAdminServer(db *gorm.DB, authClient authpb.OAuthClient) *AdminServer {
 		CustomerCtrl:     controller.NewCustomerController(customerService),
 		PermissionCtrl:   controller.NewPermissionController(permissionService),
 		OAuthCtrl:        controller.NewOAuthController(oauthService),
-		OrganizationCtrl: controller.NewOrganizationController(service.NewOrganizationService(db)),
+		OrganizationCtrl: controller.NewOrganizationController(service.NewOrganizationService(db, orgAccess)),
+		OrganizationUserCtrl: controller.NewOrganizationUserController(service.NewOrganizationUserService(db, orgAccess)),
 		ProductCtrl:      controller.NewProductController(service.NewProductService(db)),
 		ProductCategoryCtrl: controller.NewProductCategoryController(service.NewProductCategoryService(db)),
 		SupplierCtrl:     controller.NewSupplierController(service.NewSupplierService(db)),
@@ -165,6 +167,24 @@ func (s *AdminServer) ListOrganizations(ctx context.Context, req *adminpb.ListOr
 	return s.OrganizationCtrl.List(ctx, req)
 }
 
+// --- Organization Membership ---
+
+func (s *AdminServer) AddOrganizationUser(ctx context.Context, req *adminpb.AddOrganizationUserRequest) (*adminpb.AddOrganizationUserResponse, error) {
+	return s.OrganizationUserCtrl.Add(ctx, req)
+}
+
+func (s *AdminServer) ListOrganizationUsers(ctx context.Context, req *adminpb.ListOrganizationUsersRequest) (*adminpb.ListOrganizationUsersResponse, error) {
+	return s.OrganizationUserCtrl.List(ctx, req)
+}
+
+func (s *AdminServer) UpdateOrganizationUserRole(ctx context.Context, req *adminpb.UpdateOrganizationUserRoleRequest) (*adminpb.UpdateOrganizationUserRoleResponse, error) {
+	return s.OrganizationUserCtrl.UpdateRole(ctx, req)
+}
+
+func (s *AdminServer) RemoveOrganizationUser(ctx context.Context, req *adminpb.RemoveOrganizationUserRequest) (*adminpb.RemoveOrganizationUserResponse, error) {
+	return s.OrganizationUserCtrl.Remove(ctx, req)
+}
+
 // --- Product CRUD ---
 
 func (s *AdminServer) CreateProduct(ctx context.Context, req *adminpb.CreateProductRequest) (*adminpb.CreateProductResponse, error) {
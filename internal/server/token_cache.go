@@ -0,0 +1,73 @@
+package server
+
+import (
+	"crypto/sha256"
+	"time"
+
+	"persacc/internal/cache"
+	"persacc/internal/entity"
+)
+
+// cachedToken is the outcome of verifying an access token and syncing its
+// user with the local database.
+type cachedToken struct {
+	Email string
+	Name  string
+	Uuid  string
+	Exp   int64
+	User  entity.User
+}
+
+// TokenCache remembers verified access tokens, keyed by their SHA-256 hash,
+// so that repeated calls with the same token skip the OAuth Verify round-trip
+// and the user lookup. Entries never outlive the token's expiry.
+type TokenCache struct {
+	cache *cache.TTL[[sha256.Size]byte, cachedToken]
+	now   func() time.Time
+}
+
+func NewTokenCache(ttl time.Duration, maxEntries int) *TokenCache {
+	return &TokenCache{
+		cache: cache.NewTTL[[sha256.Size]byte, cachedToken](ttl, maxEntries),
+		now:   time.Now,
+	}
+}
+
+func tokenKey(accessToken string) [sha256.Size]byte {
+	return sha256.Sum256([]byte(accessToken))
+}
+
+func (c *TokenCache) get(accessToken string) (cachedToken, bool) {
+	return c.cache.Get(tokenKey(accessToken))
+}
+
+func (c *TokenCache) set(accessToken string, entry cachedToken) {
+	ttl := time.Duration(1<<63 - 1)
+	if entry.Exp > 0 {
+		ttl = time.Unix(entry.Exp, 0).Sub(c.now())
+	}
+	c.cache.SetWithTTL(tokenKey(accessToken), entry, ttl)
+}
+
+// InvalidateUser drops every cached token of the user.
+func (c *TokenCache) InvalidateUser(userID int64) {
+	c.cache.DeleteFunc(func(_ [sha256.Size]byte, e cachedToken) bool {
+		return e.User.ID == userID
+	})
+}
+
+// InvalidateRole drops every cached token whose user has the role.
+func (c *TokenCache) InvalidateRole(roleID int64) {
+	c.cache.DeleteFunc(func(_ [sha256.Size]byte, e cachedToken) bool {
+		return e.User.RoleID == roleID
+	})
+}
+
+// InvalidateAll empties the cache.
+func (c *TokenCache) InvalidateAll() {
+	c.cache.Clear()
+}
+
+func (c *TokenCache) Stats() cache.Stats {
+	return c.cache.Stats()
+}
@@ -0,0 +1,43 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"persacc/internal/entity"
+)
+
+func TestTokenCacheHonoursExp(t *testing.T) {
+	c := NewTokenCache(time.Hour, 10)
+
+	c.set("expired", cachedToken{Exp: time.Now().Add(-time.Minute).Unix()})
+	if _, ok := c.get("expired"); ok {
+		t.Error("expired token was cached")
+	}
+
+	c.set("valid", cachedToken{Exp: time.Now().Add(time.Minute).Unix()})
+	if _, ok := c.get("valid"); !ok {
+		t.Error("valid token was not cached")
+	}
+}
+
+func TestTokenCacheInvalidation(t *testing.T) {
+	c := NewTokenCache(time.Hour, 10)
+	exp := time.Now().Add(time.Minute).Unix()
+	c.set("a", cachedToken{Exp: exp, User: entity.User{ID: 1, RoleID: 10}})
+	c.set("b", cachedToken{Exp: exp, User: entity.User{ID: 2, RoleID: 10}})
+	c.set("c", cachedToken{Exp: exp, User: entity.User{ID: 3, RoleID: 20}})
+
+	c.InvalidateUser(1)
+	if _, ok := c.get("a"); ok {
+		t.Error("InvalidateUser kept the user's token")
+	}
+
+	c.InvalidateRole(10)
+	if _, ok := c.get("b"); ok {
+		t.Error("InvalidateRole kept a token of the role")
+	}
+	if _, ok := c.get("c"); !ok {
+		t.Error("InvalidateRole dropped a token of another role")
+	}
+}
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"persacc/internal/entity"
+	"persacc/internal/principal"
+	"persacc/internal/rbac"
+	"persacc/internal/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// UserSync resolves the local user of an authenticated token, creating it
+// on first sign-in, and caches it with the token.
+type UserSync struct {
+	DB     *gorm.DB
+	Tokens *TokenCache
+}
+
+func NewUserSync(db *gorm.DB, tokens *TokenCache) *UserSync {
+	return &UserSync{
+		DB:     db,
+		Tokens: tokens,
+	}
+}
+
+func (u *UserSync) Unary() grpc.UnaryServerInterceptor {
+	return unaryStage(u.sync)
+}
+
+func (u *UserSync) Stream() grpc.StreamServerInterceptor {
+	return streamStage(u.sync)
+}
+
+func (u *UserSync) sync(ctx context.Context, method string, req interface{}) (context.Context, error) {
+	// Register creates the user itself
+	if rbac.IsPublic(method) || method == registerMethod {
+		return ctx, nil
+	}
+
+	auth, err := authenticatedFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := principalFrom(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := auth.entry
+	if !auth.cached {
+		syncCtx, span := tracer.Start(ctx, "auth.SyncUser")
+		user, err := u.syncUser(syncCtx, entry)
+		endSpan(span, err)
+		if err != nil {
+			return nil, err
+		}
+		entry.User = user
+		u.Tokens.set(auth.token, entry)
+	}
+
+	p.User = entry.User
+	return principal.NewContext(ctx, &p), nil
+}
+
+// syncUser finds the local user of a verified token by UUID or email,
+// creating it with the default role on first sign-in. The user is returned
+// with its role and permissions loaded.
+func (u *UserSync) syncUser(ctx context.Context, entry cachedToken) (entity.User, error) {
+	db := u.DB.WithContext(ctx)
+	userEmail := entry.Email
+	userUuid := entry.Uuid
+	var user entity.User
+
+	foundByUuid := false
+	if userUuid != "" {
+		if err := db.Preload("Role.Permissions").First(&user, "uuid = ?", userUuid).Error; err == nil {
+			foundByUuid = true
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return user, status.Errorf(codes.Internal, "failed to check user by uuid: %v", err)
+		}
+	}
+
+	if !foundByUuid {
+		if err := db.Preload("Role.Permissions").First(&user, "email = ?", userEmail).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				// User not found, create a new one with the default role
+				var defaultRole entity.Role
+				if err := db.Where("name = ?", rbac.RoleUser).First(&defaultRole).Error; err != nil {
+					if errors.Is(err, gorm.ErrRecordNotFound) {
+						return user, status.Error(codes.FailedPrecondition, service.ErrDefaultRoleMissing.Error())
+					}
+					return user, status.Errorf(codes.Internal, "failed to load default role: %v", err)
+				}
+				user = entity.User{
+					Name:   entry.Name,
+					Email:  userEmail,
+					Uuid:   userUuid,
+					RoleID: defaultRole.ID,
+				}
+				if err := db.Create(&user).Error; err != nil {
+					return user, status.Errorf(codes.Internal, "failed to create user: %v", err)
+				}
+				// Reload to get the Role for the role checks that follow
+				if err := db.Preload("Role.Permissions").First(&user, user.ID).Error; err != nil {
+					return user, status.Errorf(codes.Internal, "failed to load created user: %v", err)
+				}
+			} else {
+				return user, status.Errorf(codes.Internal, "failed to check user by email: %v", err)
+			}
+		} else if user.Uuid == "" && userUuid != "" {
+			// User found by email but missing UUID, update it. Users invited
+			// or bootstrapped by email also get their name on first sign-in.
+			user.Uuid = userUuid
+			if user.Name == "" {
+				user.Name = entry.Name
+			}
+			if err := db.Save(&user).Error; err != nil {
+				return user, status.Errorf(codes.Internal, "failed to update user uuid: %v", err)
+			}
+		}
+	}
+	return user, nil
+}
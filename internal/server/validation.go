@@ -0,0 +1,45 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"persacc/internal/validate"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// ValidationUnaryInterceptor rejects requests that break the rules of their
+// message type with InvalidArgument and a BadRequest listing every invalid
+// field.
+func ValidationUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return unaryStage(validateRequest)
+}
+
+func ValidationStreamInterceptor() grpc.StreamServerInterceptor {
+	return streamStage(validateRequest)
+}
+
+func validateRequest(ctx context.Context, method string, req interface{}) (context.Context, error) {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return ctx, nil
+	}
+	violations := validate.Message(m)
+	if len(violations) == 0 {
+		return ctx, nil
+	}
+	br := &errdetails.BadRequest{}
+	msgs := make([]string, len(violations))
+	for i, v := range violations {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+		msgs[i] = v.String()
+	}
+	return nil, withDetails(codes.InvalidArgument, "invalid request: "+strings.Join(msgs, "; "), br)
+}
@@ -0,0 +1,22 @@
+package service
+
+// AuthCacheInvalidator is notified when users, roles or permissions change so
+// that cached authentication results are not used past the change.
+type AuthCacheInvalidator interface {
+	InvalidateUser(userID int64)
+	InvalidateRole(roleID int64)
+	InvalidateAll()
+}
+
+type noopAuthCache struct{}
+
+func (noopAuthCache) InvalidateUser(int64) {}
+func (noopAuthCache) InvalidateRole(int64) {}
+func (noopAuthCache) InvalidateAll()       {}
+
+func authCacheOrNoop(c AuthCacheInvalidator) AuthCacheInvalidator {
+	if c == nil {
+		return noopAuthCache{}
+	}
+	return c
+}
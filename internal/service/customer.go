@@ -2,72 +2,206 @@ package service
 
 import (
 	"context"
+	"errors"
+	"reflect"
+	"strconv"
 
+	"persacc/internal/data"
 	"persacc/internal/entity"
+	"persacc/internal/listquery"
+	"persacc/internal/pagination"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// customerFields are the fields ListCustomers can filter and order by.
+var customerFields = listquery.Fields{
+	"id":         {Column: "id", Kind: listquery.Int, Sortable: true},
+	"name":       {Column: "name", Kind: listquery.String, Sortable: true},
+	"first_name": {Column: "first_name", Kind: listquery.String},
+	"last_name":  {Column: "last_name", Kind: listquery.String},
+	"email":      {Column: "email", Kind: listquery.String},
+	"phone":      {Column: "phone", Kind: listquery.String},
+	"user_id":    {Column: "user_id", Kind: listquery.Int},
+	"created_at": {Column: "created_at", Kind: listquery.Time, Sortable: true},
+	"updated_at": {Column: "updated_at", Kind: listquery.Time, Sortable: true},
+}
+
 type CustomerService struct {
-	DB *gorm.DB
+	DB     *gorm.DB
+	Access *OrganizationAccessService
 }
 
-func NewCustomerService(db *gorm.DB) *CustomerService {
-	return &CustomerService{DB: db}
+func NewCustomerService(db *gorm.DB, access *OrganizationAccessService) *CustomerService {
+	return &CustomerService{DB: db, Access: access}
 }
 
-func (s *CustomerService) Create(ctx context.Context, customer *entity.Customer, organizationID int64) error {
-	return s.DB.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Create(customer).Error; err != nil {
-			return err
+// ErrCustomerShared is returned for changes to the customer itself, rather
+// than to an organization's link to it, while other organizations link to
+// the customer too.
+var ErrCustomerShared = errors.New("customer is shared with other organizations, only its description, tags and notes can be changed")
+
+// Create adds link.Customer and links it to link.OrganizationID on behalf of
+// userID. An existing customer with the same user_id is linked instead only
+// if userID can already read it as the owner or a member of an organization
+// linked to it, and only if the fields set on link.Customer match it.
+// Otherwise Create fails with a ConflictError that does not identify the
+// customer. A deleted customer, which no organization links to, is
+// restored with the fields of link.Customer.
+func (s *CustomerService) Create(ctx context.Context, link *entity.OrganizationCustomer, userID int64) error {
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing entity.Customer
+		if link.Customer.UserID != nil {
+			err := tx.Unscoped().Where("user_id = ?", *link.Customer.UserID).Take(&existing).Error
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
 		}
-		orgCustomer := entity.OrganizationCustomer{
-			OrganizationID: organizationID,
-			CustomerID:     customer.ID,
+
+		switch {
+		case existing.ID == 0:
+			if err := tx.Create(&link.Customer).Error; err != nil {
+				return err
+			}
+		case existing.DeletedAt.Valid:
+			link.Customer.ID = existing.ID
+			link.Customer.Version = existing.Version + 1
+			if err := tx.Unscoped().Select("*").Omit("id", "created_at").Updates(&link.Customer).Error; err != nil {
+				return err
+			}
+			link.Customer.CreatedAt = existing.CreatedAt
+		default:
+			if err := s.checkLink(ctx, tx, link, &existing, userID); err != nil {
+				return err
+			}
+			link.Customer = existing
 		}
-		return tx.Create(&orgCustomer).Error
+		link.CustomerID = link.Customer.ID
+		return tx.Omit(clause.Associations).Create(link).Error
 	})
+	return dbError("customer", err)
 }
 
-func (s *CustomerService) Get(ctx context.Context, id int64, organizationID int64) (*entity.Customer, error) {
-	var customer entity.Customer
-	err := s.DB.Joins("JOIN organization_customers ON organization_customers.customer_id = customers.id").
-		Where("customers.id = ? AND organization_customers.organization_id = ?", id, organizationID).
-		First(&customer).Error
-	if err != nil {
-		return nil, err
+// checkLink allows linking the existing customer to link.OrganizationID.
+func (s *CustomerService) checkLink(ctx context.Context, tx *gorm.DB, link *entity.OrganizationCustomer, existing *entity.Customer, userID int64) error {
+	var orgIDs []int64
+	if err := tx.Model(&entity.OrganizationCustomer{}).Where("customer_id = ?", existing.ID).Pluck("organization_id", &orgIDs).Error; err != nil {
+		return err
+	}
+	readable := false
+	for _, orgID := range orgIDs {
+		if orgID == link.OrganizationID {
+			return &ConflictError{Resource: "customer", Field: "user_id", Value: strconv.FormatInt(*existing.UserID, 10), ID: strconv.FormatInt(existing.ID, 10)}
+		}
+		_, err := s.Access.Role(ctx, orgID, userID)
+		if err == nil {
+			readable = true
+		} else if !errors.Is(err, ErrOrganizationAccessDenied) && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+	}
+	if !readable {
+		return &ConflictError{Resource: "customer", Field: "user_id", Value: strconv.FormatInt(*existing.UserID, 10)}
+	}
+	if violations := customerMismatches(link.Customer, *existing); len(violations) > 0 {
+		return &ValidationError{Violations: violations}
 	}
-	return &customer, nil
+	return nil
 }
 
-func (s *CustomerService) Update(ctx context.Context, customer *entity.Customer, organizationID int64) error {
-	// Verify relationship exists
-	var count int64
-	s.DB.Model(&entity.OrganizationCustomer{}).
-		Where("customer_id = ? AND organization_id = ?", customer.ID, organizationID).
-		Count(&count)
-	if count == 0 {
-		return gorm.ErrRecordNotFound
+// customerMismatches lists the fields set on c that differ from existing.
+func customerMismatches(c, existing entity.Customer) []FieldViolation {
+	var violations []FieldViolation
+	differs := func(field string, set, equal bool) {
+		if set && !equal {
+			violations = append(violations, FieldViolation{Field: field, Description: "does not match the existing customer with this user_id"})
+		}
 	}
-	return s.DB.Save(customer).Error
+	differs("name", c.Name != "", c.Name == existing.Name)
+	differs("first_name", c.FirstName != "", c.FirstName == existing.FirstName)
+	differs("last_name", c.LastName != "", c.LastName == existing.LastName)
+	differs("prefix", c.Prefix != "", c.Prefix == existing.Prefix)
+	differs("middle_name", c.MiddleName != "", c.MiddleName == existing.MiddleName)
+	differs("suffix", c.Suffix != "", c.Suffix == existing.Suffix)
+	differs("birthday", c.Birthday != nil, c.Birthday == nil || existing.Birthday != nil && c.Birthday.Equal(*existing.Birthday))
+	differs("phone", c.Phone != "", c.Phone == existing.Phone)
+	differs("email", c.Email != "", c.Email == existing.Email)
+	differs("additional_info", len(c.AdditionalInfo) > 0, reflect.DeepEqual(c.AdditionalInfo, existing.AdditionalInfo))
+	return violations
+}
+
+// Get returns the organization's link to the customer with id, with the
+// customer.
+func (s *CustomerService) Get(ctx context.Context, id int64, organizationID int64) (*entity.OrganizationCustomer, error) {
+	var link entity.OrganizationCustomer
+	err := data.Reader(ctx, s.DB).Preload("Customer").
+		Where("customer_id = ? AND organization_id = ?", id, organizationID).
+		Take(&link).Error
+	if err != nil {
+		return nil, rowError("customer", id, err)
+	}
+	return &link, nil
+}
+
+// Update writes the changes from before to link, provided the link is still
+// at version. Changes to the customer itself are only allowed while no
+// other organization links to it.
+func (s *CustomerService) Update(ctx context.Context, before, link *entity.OrganizationCustomer, version int64) error {
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if !reflect.DeepEqual(before.Customer, link.Customer) {
+			shared, err := linkedElsewhere(tx, link.CustomerID, link.OrganizationID)
+			if err != nil {
+				return err
+			}
+			if shared {
+				return ErrCustomerShared
+			}
+			if err := data.UpdateChanged(tx, &before.Customer, &link.Customer, before.Customer.Version); err != nil {
+				return err
+			}
+		}
+		return data.UpdateChanged(tx, before, link, version)
+	})
+	return rowError("customer", link.CustomerID, err)
 }
 
-func (s *CustomerService) Delete(ctx context.Context, id int64, organizationID int64) error {
-	return s.DB.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Where("customer_id = ? AND organization_id = ?", id, organizationID).
-			Delete(&entity.OrganizationCustomer{}).Error; err != nil {
+// Delete unlinks the customer with id from the organization, provided the
+// link is at version. The customer is deleted with its last link.
+func (s *CustomerService) Delete(ctx context.Context, id int64, organizationID int64, version int64) error {
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var link entity.OrganizationCustomer
+		if err := tx.Select("id").Where("customer_id = ? AND organization_id = ?", id, organizationID).Take(&link).Error; err != nil {
+			return err
+		}
+		if err := data.DeleteVersioned(tx, &entity.OrganizationCustomer{}, link.ID, version); err != nil {
 			return err
 		}
-		return tx.Delete(&entity.Customer{}, "id = ?", id).Error
+		shared, err := linkedElsewhere(tx, id, organizationID)
+		if err != nil || shared {
+			return err
+		}
+		return tx.Delete(&entity.Customer{}, id).Error
 	})
+	return rowError("customer", id, err)
 }
 
-func (s *CustomerService) List(ctx context.Context, limit, offset int, organizationID int64, filters map[string]string) ([]entity.Customer, int64, error) {
-	var customers []entity.Customer
-	var total int64
+// linkedElsewhere reports whether organizations other than organizationID
+// link to the customer.
+func linkedElsewhere(db *gorm.DB, customerID, organizationID int64) (bool, error) {
+	var count int64
+	err := db.Model(&entity.OrganizationCustomer{}).
+		Where("customer_id = ? AND organization_id <> ?", customerID, organizationID).
+		Count(&count).Error
+	return count > 0, err
+}
 
-	query := s.DB.Model(&entity.Customer{}).
-		Joins("JOIN organization_customers ON organization_customers.customer_id = customers.id").
+// List returns the organization's links to its customers, with the
+// customers. Filters and the order apply to the customers.
+func (s *CustomerService) List(ctx context.Context, page pagination.Request, lq listquery.Request, organizationID int64, filters map[string]string) (*pagination.Page[entity.OrganizationCustomer], error) {
+	db := data.Reader(ctx, s.DB)
+	query := db.Model(&entity.Customer{}).
+		Joins("JOIN organization_customers ON organization_customers.customer_id = customers.id AND organization_customers.deleted_at IS NULL").
 		Where("organization_customers.organization_id = ?", organizationID)
 
 	if name, ok := filters["name"]; ok && name != "" {
@@ -84,10 +218,35 @@ func (s *CustomerService) List(ctx context.Context, limit, offset int, organizat
 		query = query.Where("customers.additional_info::text ILIKE ?", "%"+info+"%")
 	}
 
-	query.Count(&total)
-	if err := query.Limit(limit).Offset(offset).Find(&customers).Error; err != nil {
-		return nil, 0, err
+	q, err := customerFields.Parse(lq)
+	if err != nil {
+		return nil, err
+	}
+	customers, err := pagination.Find[entity.Customer](q.Apply(query), page, q.Order)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, len(customers.Items))
+	for i, c := range customers.Items {
+		ids[i] = c.ID
+	}
+	var links []entity.OrganizationCustomer
+	if len(ids) > 0 {
+		if err := db.Where("organization_id = ? AND customer_id IN ?", organizationID, ids).Find(&links).Error; err != nil {
+			return nil, err
+		}
+	}
+	byCustomer := make(map[int64]entity.OrganizationCustomer, len(links))
+	for _, l := range links {
+		byCustomer[l.CustomerID] = l
 	}
 
-	return customers, total, nil
+	result := &pagination.Page[entity.OrganizationCustomer]{Total: customers.Total, NextPageToken: customers.NextPageToken}
+	for _, c := range customers.Items {
+		link := byCustomer[c.ID]
+		link.Customer = c
+		result.Items = append(result.Items, link)
+	}
+	return result, nil
 }
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"persacc/internal/data"
+	"persacc/internal/data/datatest"
+	"persacc/internal/entity"
+
+	"gorm.io/gorm"
+)
+
+// Editing an organization's link leaves the shared customer row alone.
+func TestUpdateLinkOnly(t *testing.T) {
+	db, rec := datatest.DryRun(t)
+	before := entity.OrganizationCustomer{ID: 3, OrganizationID: 1, CustomerID: 8, Version: 2, Customer: entity.Customer{ID: 8, Name: "Ada"}}
+	link := before
+	link.Notes = "prefers email"
+	link.Tags = []string{"vip"}
+
+	_ = data.UpdateChanged(db, &before, &link, before.Version)
+	for _, sql := range rec.Statements {
+		if strings.Contains(sql, `"customers"`) {
+			t.Errorf("link update wrote the customer: %q", sql)
+		}
+	}
+	got := rec.Statements[0]
+	for _, want := range []string{`UPDATE "organization_customers"`, `"notes"='prefers email'`, `"tags"='["vip"]'`, `version = 2`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("update %q lacks %q", got, want)
+		}
+	}
+}
+
+func TestCustomerMismatches(t *testing.T) {
+	birthday := time.Date(1815, 12, 10, 0, 0, 0, 0, time.UTC)
+	existing := entity.Customer{Name: "Ada", Phone: "+441234567890", Birthday: &birthday}
+
+	// Fields the request leaves unset are not compared
+	if got := customerMismatches(entity.Customer{Name: "Ada"}, existing); len(got) != 0 {
+		t.Errorf("matching request has mismatches %v", got)
+	}
+	other := birthday.AddDate(0, 0, 1)
+	got := customerMismatches(entity.Customer{Name: "Ada", Phone: "+440000000000", Birthday: &other}, existing)
+	if len(got) != 2 || got[0].Field != "birthday" || got[1].Field != "phone" {
+		t.Errorf("mismatches = %v, want birthday and phone", got)
+	}
+}
+
+// customerTest runs CustomerService against a migrated test database.
+type customerTest struct {
+	t  *testing.T
+	db *gorm.DB
+	s  *CustomerService
+}
+
+func newCustomerTest(t *testing.T) *customerTest {
+	t.Helper()
+	db := datatest.Postgres(t)
+	return &customerTest{t: t, db: db, s: NewCustomerService(db, NewOrganizationAccessService(db))}
+}
+
+// organization creates an organization owned by a new user and returns the
+// ids of both.
+func (ct *customerTest) organization(name string) (orgID, ownerID int64) {
+	ct.t.Helper()
+	if err := ct.db.Raw("INSERT INTO users (email) VALUES (?) RETURNING id", name+"@example.com").Scan(&ownerID).Error; err != nil {
+		ct.t.Fatal(err)
+	}
+	if err := ct.db.Raw("INSERT INTO organizations (owner_id, name) VALUES (?, ?) RETURNING id", ownerID, name).Scan(&orgID).Error; err != nil {
+		ct.t.Fatal(err)
+	}
+	return orgID, ownerID
+}
+
+func (ct *customerTest) addMember(orgID, userID int64) {
+	ct.t.Helper()
+	err := ct.db.Exec("INSERT INTO organization_users (organization_id, user_id, role) VALUES (?, ?, ?)", orgID, userID, entity.OrganizationRoleManager).Error
+	if err != nil {
+		ct.t.Fatal(err)
+	}
+}
+
+// create adds the customer of userID to orgID on behalf of actor.
+func (ct *customerTest) create(orgID, actor, userID int64, name, phone string) (*entity.OrganizationCustomer, error) {
+	link := &entity.OrganizationCustomer{
+		OrganizationID: orgID,
+		Customer:       entity.Customer{Name: name, Phone: phone, UserID: &userID},
+	}
+	return link, ct.s.Create(context.Background(), link, actor)
+}
+
+func (ct *customerTest) mustCreate(orgID, actor, userID int64, name, phone string) *entity.OrganizationCustomer {
+	ct.t.Helper()
+	link, err := ct.create(orgID, actor, userID, name, phone)
+	if err != nil {
+		ct.t.Fatal(err)
+	}
+	return link
+}
+
+// customer reads the customer row, deleted or not.
+func (ct *customerTest) customer(id int64) entity.Customer {
+	ct.t.Helper()
+	var c entity.Customer
+	if err := ct.db.Unscoped().First(&c, id).Error; err != nil {
+		ct.t.Fatal(err)
+	}
+	return c
+}
+
+func TestCreateLinksReadableCustomer(t *testing.T) {
+	ct := newCustomerTest(t)
+	orgA, ada := ct.organization("a")
+	orgB, _ := ct.organization("b")
+	ct.addMember(orgB, ada)
+
+	first := ct.mustCreate(orgA, ada, 42, "Ada Lovelace", "+441234567890")
+	second := ct.mustCreate(orgB, ada, 42, "Ada Lovelace", "")
+	if second.CustomerID != first.CustomerID || second.ID == first.ID {
+		t.Errorf("links %+v and %+v, want two links to one customer", first, second)
+	}
+	if second.Customer.Phone != "+441234567890" {
+		t.Errorf("linked customer = %+v, want the stored one", second.Customer)
+	}
+
+	// The same customer cannot be linked to an organization twice
+	_, err := ct.create(orgA, ada, 42, "Ada Lovelace", "")
+	var conflict *ConflictError
+	if !errors.As(err, &conflict) || conflict.ID != strconv.FormatInt(first.CustomerID, 10) {
+		t.Errorf("duplicate link: got %v, want a ConflictError naming customer %d", err, first.CustomerID)
+	}
+}
+
+func TestCreateRejectsUnreadableCustomer(t *testing.T) {
+	ct := newCustomerTest(t)
+	orgA, ada := ct.organization("a")
+	orgB, _ := ct.organization("b")
+	orgC, eve := ct.organization("c")
+	ct.addMember(orgB, ada)
+	ct.mustCreate(orgA, ada, 42, "Ada Lovelace", "+441234567890")
+
+	link, err := ct.create(orgC, eve, 42, "Ada Lovelace", "")
+	var conflict *ConflictError
+	if !errors.As(err, &conflict) || conflict.ID != "" {
+		t.Errorf("got %v, want a ConflictError without the customer id", err)
+	}
+	if link.Customer.Phone != "" {
+		t.Errorf("a rejected request got the stored customer %+v", link.Customer)
+	}
+	var links int64
+	ct.db.Model(&entity.OrganizationCustomer{}).Where("organization_id = ?", orgC).Count(&links)
+	if links != 0 {
+		t.Errorf("%d links were created", links)
+	}
+
+	// A caller who can read the customer must still agree with it
+	_, err = ct.create(orgB, ada, 42, "Ada King", "")
+	var validation *ValidationError
+	if !errors.As(err, &validation) || validation.Violations[0].Field != "name" {
+		t.Errorf("got %v, want a ValidationError for name", err)
+	}
+}
+
+func TestCreateRestoresDeletedCustomer(t *testing.T) {
+	ct := newCustomerTest(t)
+	ctx := context.Background()
+	orgA, ada := ct.organization("a")
+	orgC, eve := ct.organization("c")
+
+	first := ct.mustCreate(orgA, ada, 42, "Ada Lovelace", "+441234567890")
+	if err := ct.s.Delete(ctx, first.CustomerID, orgA, first.Version); err != nil {
+		t.Fatal(err)
+	}
+	if c := ct.customer(first.CustomerID); !c.DeletedAt.Valid {
+		t.Fatal("the customer was not deleted with its last link")
+	}
+
+	link := ct.mustCreate(orgC, eve, 42, "Ada King", "")
+	if link.CustomerID != first.CustomerID {
+		t.Fatalf("created customer %d, want customer %d restored", link.CustomerID, first.CustomerID)
+	}
+	c := ct.customer(first.CustomerID)
+	if c.DeletedAt.Valid || c.Name != "Ada King" || c.Phone != "" {
+		t.Errorf("restored customer = %+v, want the fields of the request", c)
+	}
+}
+
+func TestUpdateSharedCustomer(t *testing.T) {
+	ct := newCustomerTest(t)
+	ctx := context.Background()
+	orgA, ada := ct.organization("a")
+	orgB, _ := ct.organization("b")
+	ct.addMember(orgB, ada)
+	first := ct.mustCreate(orgA, ada, 42, "Ada Lovelace", "")
+	ct.mustCreate(orgB, ada, 42, "Ada Lovelace", "")
+
+	before, err := ct.s.Get(ctx, first.CustomerID, orgA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	link := *before
+	link.Customer.Name = "Ada King"
+	if err := ct.s.Update(ctx, before, &link, before.Version); !errors.Is(err, ErrCustomerShared) {
+		t.Errorf("changing a shared customer: got %v, want ErrCustomerShared", err)
+	}
+
+	link = *before
+	link.Notes = "prefers email"
+	if err := ct.s.Update(ctx, before, &link, before.Version); err != nil {
+		t.Errorf("changing the organization's notes: %v", err)
+	}
+}
+
+func TestDeleteKeepsSharedCustomer(t *testing.T) {
+	ct := newCustomerTest(t)
+	ctx := context.Background()
+	orgA, ada := ct.organization("a")
+	orgB, _ := ct.organization("b")
+	ct.addMember(orgB, ada)
+	first := ct.mustCreate(orgA, ada, 42, "Ada Lovelace", "")
+	second := ct.mustCreate(orgB, ada, 42, "Ada Lovelace", "")
+
+	if err := ct.s.Delete(ctx, first.CustomerID, orgA, first.Version); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ct.s.Get(ctx, first.CustomerID, orgA); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("Get after unlinking: got %v, want not found", err)
+	}
+	if c := ct.customer(first.CustomerID); c.DeletedAt.Valid {
+		t.Error("the customer was deleted while another organization links to it")
+	}
+
+	if err := ct.s.Delete(ctx, first.CustomerID, orgB, second.Version); err != nil {
+		t.Fatal(err)
+	}
+	if c := ct.customer(first.CustomerID); !c.DeletedAt.Valid {
+		t.Error("the customer was kept after its last link was removed")
+	}
+}
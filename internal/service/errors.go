@@ -0,0 +1,160 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// NotFoundError reports a resource that does not exist or is not visible
+// to the caller. It matches gorm.ErrRecordNotFound with errors.Is.
+type NotFoundError struct {
+	Resource string
+	ID       string
+}
+
+func (e *NotFoundError) Error() string {
+	if e.ID == "" {
+		return e.Resource + " not found"
+	}
+	return fmt.Sprintf("%s %s not found", e.Resource, e.ID)
+}
+
+func (e *NotFoundError) Is(target error) bool {
+	return target == gorm.ErrRecordNotFound
+}
+
+// ConflictError reports a resource that would duplicate an existing one on
+// a unique Field. ID names the existing resource when it is known.
+type ConflictError struct {
+	Resource string
+	Field    string
+	Value    string
+	ID       string
+}
+
+func (e *ConflictError) Error() string {
+	switch {
+	case e.Field == "":
+		return e.Resource + " already exists"
+	case e.ID != "":
+		return fmt.Sprintf("%s %q is already used by %s %s", e.Field, e.Value, e.Resource, e.ID)
+	}
+	return fmt.Sprintf("%s with %s %q already exists", e.Resource, e.Field, e.Value)
+}
+
+// FieldViolation describes one invalid field of a request.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// ValidationError reports request fields with invalid values.
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+// NewValidationError returns a ValidationError for a single field.
+func NewValidationError(field, description string) *ValidationError {
+	return &ValidationError{Violations: []FieldViolation{{Field: field, Description: description}}}
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		parts[i] = v.Field + ": " + v.Description
+	}
+	return "invalid request: " + strings.Join(parts, "; ")
+}
+
+// ForeignKeyError reports a reference to a row of Table that does not
+// exist or, with InUse, a row that cannot be deleted because Table still
+// refers to it.
+type ForeignKeyError struct {
+	Field string
+	Value string
+	Table string
+	InUse bool
+}
+
+func (e *ForeignKeyError) Error() string {
+	if e.InUse {
+		return fmt.Sprintf("%s %s is still referenced by %s", e.Field, e.Value, e.Table)
+	}
+	return fmt.Sprintf("%s %s does not exist in %s", e.Field, e.Value, e.Table)
+}
+
+// Postgres error codes translated by dbError.
+const (
+	pgStringTooLong       = "22001"
+	pgNotNullViolation    = "23502"
+	pgForeignKeyViolation = "23503"
+	pgUniqueViolation     = "23505"
+)
+
+var (
+	// Key (organization_id, sku)=(1, CH-1) already exists.
+	keyDetailPattern = regexp.MustCompile(`^Key \((.+?)\)=\((.*)\) `)
+	// ... is not present in table "product_categories".
+	tableDetailPattern = regexp.MustCompile(`table "([^"]+)"`)
+)
+
+// dbError translates err from a query on resource into a domain error,
+// leaving errors it does not recognize unchanged.
+func dbError(resource string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		var notFound *NotFoundError
+		if errors.As(err, &notFound) {
+			return err
+		}
+		return &NotFoundError{Resource: resource}
+	}
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	var field, value, table string
+	if m := keyDetailPattern.FindStringSubmatch(pgErr.Detail); m != nil {
+		field, value = m[1], m[2]
+	}
+	// Keys scoped to an organization, such as (organization_id, sku), end
+	// with the column the request set
+	if cols := strings.Split(field, ", "); len(cols) > 1 {
+		field = cols[len(cols)-1]
+		value = strings.SplitN(value, ", ", len(cols))[len(cols)-1]
+	}
+	if m := tableDetailPattern.FindStringSubmatch(pgErr.Detail); m != nil {
+		table = m[1]
+	}
+	switch pgErr.Code {
+	case pgUniqueViolation:
+		return &ConflictError{Resource: resource, Field: field, Value: value}
+	case pgForeignKeyViolation:
+		return &ForeignKeyError{Field: field, Value: value, Table: table, InUse: strings.Contains(pgErr.Detail, "still referenced")}
+	case pgNotNullViolation:
+		return NewValidationError(pgErr.ColumnName, "is required")
+	case pgStringTooLong:
+		// Postgres does not name the column of an overlong value
+		return NewValidationError(resource, pgErr.Message)
+	}
+	return err
+}
+
+// rowError is dbError for a query on the resource with id.
+func rowError(resource string, id int64, err error) error {
+	err = dbError(resource, err)
+	var notFound *NotFoundError
+	if errors.As(err, &notFound) && notFound.ID == "" {
+		notFound.ID = strconv.FormatInt(id, 10)
+	}
+	return err
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+func pgError(code, detail string) error {
+	return &pgconn.PgError{Code: code, Detail: detail}
+}
+
+func TestDBError(t *testing.T) {
+	err := dbError("product", pgError(pgUniqueViolation, "Key (organization_id, sku)=(1, CH-1) already exists."))
+	var conflict *ConflictError
+	if !errors.As(err, &conflict) || conflict.Field != "sku" || conflict.Value != "CH-1" {
+		t.Errorf("unique violation = %#v", err)
+	}
+
+	err = dbError("product", pgError(pgForeignKeyViolation, `Key (category_id)=(9) is not present in table "product_categories".`))
+	var fk *ForeignKeyError
+	if !errors.As(err, &fk) || fk.Field != "category_id" || fk.Table != "product_categories" || fk.InUse {
+		t.Errorf("foreign key violation = %#v", err)
+	}
+
+	err = dbError("product", &pgconn.PgError{Code: pgStringTooLong, Message: "value too long for type character varying(255)"})
+	var validation *ValidationError
+	if !errors.As(err, &validation) || validation.Violations[0].Field != "product" {
+		t.Errorf("string too long = %#v", err)
+	}
+}
+
+func TestRowErrorNotFound(t *testing.T) {
+	err := rowError("vendor", 4, gorm.ErrRecordNotFound)
+	if err.Error() != "vendor 4 not found" {
+		t.Errorf("error = %q", err)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Error("NotFoundError should match gorm.ErrRecordNotFound")
+	}
+}
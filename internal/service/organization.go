@@ -3,50 +3,64 @@ package service
 import (
 	"context"
 
+	"persacc/internal/data"
 	"persacc/internal/entity"
+	"persacc/internal/listquery"
+	"persacc/internal/pagination"
 
 	"gorm.io/gorm"
 )
 
+// organizationFields are the fields ListOrganizations can filter and order by.
+var organizationFields = listquery.Fields{
+	"id":         {Column: "id", Kind: listquery.Int, Sortable: true},
+	"name":       {Column: "name", Kind: listquery.String, Sortable: true},
+	"owner_id":   {Column: "owner_id", Kind: listquery.Int},
+	"created_at": {Column: "created_at", Kind: listquery.Time, Sortable: true},
+	"updated_at": {Column: "updated_at", Kind: listquery.Time, Sortable: true},
+}
+
 type OrganizationService struct {
-	DB *gorm.DB
+	DB     *gorm.DB
+	Access *OrganizationAccessService
 }
 
-func NewOrganizationService(db *gorm.DB) *OrganizationService {
-	return &OrganizationService{DB: db}
+func NewOrganizationService(db *gorm.DB, access *OrganizationAccessService) *OrganizationService {
+	return &OrganizationService{DB: db, Access: access}
 }
 
 func (s *OrganizationService) Create(ctx context.Context, org *entity.Organization) error {
-	return s.DB.Create(org).Error
+	return dbError("organization", s.DB.WithContext(ctx).Create(org).Error)
 }
 
 func (s *OrganizationService) Get(ctx context.Context, id int64) (*entity.Organization, error) {
 	var org entity.Organization
-	if err := s.DB.First(&org, "id = ?", id).Error; err != nil {
-		return nil, err
+	if err := data.Reader(ctx, s.DB).First(&org, "id = ?", id).Error; err != nil {
+		return nil, rowError("organization", id, err)
 	}
 	return &org, nil
 }
 
-func (s *OrganizationService) Update(ctx context.Context, org *entity.Organization) error {
-	return s.DB.Save(org).Error
+func (s *OrganizationService) Update(ctx context.Context, before, org *entity.Organization, version int64) error {
+	return rowError("organization", org.ID, data.UpdateChanged(s.DB.WithContext(ctx), before, org, version))
 }
 
-func (s *OrganizationService) Delete(ctx context.Context, id int64) error {
-	return s.DB.Delete(&entity.Organization{}, "id = ?", id).Error
+func (s *OrganizationService) Delete(ctx context.Context, id int64, version int64) error {
+	if err := data.DeleteVersioned(s.DB.WithContext(ctx), &entity.Organization{}, id, version); err != nil {
+		return rowError("organization", id, err)
+	}
+	s.Access.InvalidateOrganization(id)
+	return nil
 }
 
-func (s *OrganizationService) List(ctx context.Context, limit, offset int, userId int64) ([]entity.Organization, int64, error) {
-	var orgs []entity.Organization
-	var total int64
+func (s *OrganizationService) List(ctx context.Context, page pagination.Request, lq listquery.Request, userId int64) (*pagination.Page[entity.Organization], error) {
+	q, err := organizationFields.Parse(lq)
+	if err != nil {
+		return nil, err
+	}
 
-	query := s.DB.Model(&entity.Organization{}).
+	query := data.Reader(ctx, s.DB).Model(&entity.Organization{}).
 		Where("owner_id = ? OR id IN (SELECT organization_id FROM organization_users WHERE user_id = ?)", userId, userId)
 
-	query.Count(&total)
-	if err := query.Limit(limit).Offset(offset).Find(&orgs).Error; err != nil {
-		return nil, 0, err
-	}
-
-	return orgs, total, nil
+	return pagination.Find[entity.Organization](q.Apply(query), page, q.Order)
 }
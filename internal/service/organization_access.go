@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"persacc/internal/cache"
+	"persacc/internal/entity"
+
+	"gorm.io/gorm"
+)
+
+var ErrOrganizationAccessDenied = errors.New("user does not belong to this organization")
+
+const (
+	organizationAccessTTL        = time.Minute
+	organizationAccessMaxEntries = 10000
+)
+
+type organizationAccessKey struct {
+	OrganizationID int64
+	UserID         int64
+}
+
+// OrganizationAccessService decides whether a user may act on behalf of an
+// organization and with which organization role. Results are cached
+// in-process so the interceptor does not query the database on every call.
+type OrganizationAccessService struct {
+	DB    *gorm.DB
+	cache *cache.TTL[organizationAccessKey, string]
+}
+
+func NewOrganizationAccessService(db *gorm.DB) *OrganizationAccessService {
+	return &OrganizationAccessService{
+		DB:    db,
+		cache: cache.NewTTL[organizationAccessKey, string](organizationAccessTTL, organizationAccessMaxEntries),
+	}
+}
+
+// Role returns the user's role in the organization: entity.OrganizationRoleOwner
+// for the owner, the stored role for members. It returns gorm.ErrRecordNotFound
+// when the organization does not exist or is deleted, and
+// ErrOrganizationAccessDenied when the user is neither owner nor member.
+func (s *OrganizationAccessService) Role(ctx context.Context, organizationID, userID int64) (string, error) {
+	key := organizationAccessKey{OrganizationID: organizationID, UserID: userID}
+	if role, ok := s.cache.Get(key); ok {
+		return role, nil
+	}
+
+	var org entity.Organization
+	if err := s.DB.WithContext(ctx).Select("id", "owner_id").First(&org, "id = ?", organizationID).Error; err != nil {
+		return "", rowError("organization", organizationID, err)
+	}
+
+	role := entity.OrganizationRoleOwner
+	if org.OwnerID != userID {
+		var member entity.OrganizationUser
+		err := s.DB.WithContext(ctx).Select("role").
+			Where("organization_id = ? AND user_id = ?", organizationID, userID).
+			First(&member).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", ErrOrganizationAccessDenied
+		} else if err != nil {
+			return "", err
+		}
+		role = member.Role
+	}
+
+	s.cache.Set(key, role)
+	return role, nil
+}
+
+// RequireRole is like Role but also fails with ErrOrganizationAccessDenied
+// when the user's role is not one of allowed.
+func (s *OrganizationAccessService) RequireRole(ctx context.Context, organizationID, userID int64, allowed ...string) (string, error) {
+	role, err := s.Role(ctx, organizationID, userID)
+	if err != nil {
+		return "", err
+	}
+	for _, a := range allowed {
+		if role == a {
+			return role, nil
+		}
+	}
+	return "", ErrOrganizationAccessDenied
+}
+
+// InvalidateOrganization drops every cached role for the organization.
+func (s *OrganizationAccessService) InvalidateOrganization(organizationID int64) {
+	s.cache.DeleteFunc(func(k organizationAccessKey, _ string) bool {
+		return k.OrganizationID == organizationID
+	})
+}
+
+// InvalidateMember drops the cached role of a single member.
+func (s *OrganizationAccessService) InvalidateMember(organizationID, userID int64) {
+	s.cache.Delete(organizationAccessKey{OrganizationID: organizationID, UserID: userID})
+}
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"persacc/internal/data"
+	"persacc/internal/entity"
+	"persacc/internal/listquery"
+	"persacc/internal/pagination"
+	"persacc/internal/rbac"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInvalidOrganizationRole error = NewValidationError("role", "must be one of "+
+		entity.OrganizationRoleManager+", "+entity.OrganizationRoleAccountant+", "+entity.OrganizationRoleViewer)
+	ErrOrganizationUserExists = errors.New("user is already a member of this organization")
+	ErrOrganizationOwner      = errors.New("the organization owner cannot be changed through membership")
+)
+
+// Roles allowed to manage the members of an organization.
+var memberManagerRoles = []string{entity.OrganizationRoleOwner, entity.OrganizationRoleManager}
+
+// organizationUserFields are the fields ListOrganizationUsers can filter and order by.
+var organizationUserFields = listquery.Fields{
+	"id":         {Column: "id", Kind: listquery.Int, Sortable: true},
+	"user_id":    {Column: "user_id", Kind: listquery.Int},
+	"role":       {Column: "role", Kind: listquery.String, Sortable: true},
+	"created_at": {Column: "created_at", Kind: listquery.Time, Sortable: true},
+	"updated_at": {Column: "updated_at", Kind: listquery.Time, Sortable: true},
+}
+
+type OrganizationUserService struct {
+	DB     *gorm.DB
+	Access *OrganizationAccessService
+}
+
+func NewOrganizationUserService(db *gorm.DB, access *OrganizationAccessService) *OrganizationUserService {
+	return &OrganizationUserService{DB: db, Access: access}
+}
+
+// Add makes the user identified by userID, or by email when userID is zero, a
+// member of the organization. A user that is not known locally yet is invited
+// by creating a placeholder account, which is linked to the OAuth identity on
+// first sign-in.
+func (s *OrganizationUserService) Add(ctx context.Context, actorID, organizationID, userID int64, email, role string) (*entity.OrganizationUser, error) {
+	if !entity.IsAssignableOrganizationRole(role) {
+		return nil, ErrInvalidOrganizationRole
+	}
+	if _, err := s.Access.RequireRole(ctx, organizationID, actorID, memberManagerRoles...); err != nil {
+		return nil, err
+	}
+
+	var member entity.OrganizationUser
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		user, err := s.findOrInviteUser(tx, userID, email)
+		if err != nil {
+			return err
+		}
+
+		var org entity.Organization
+		if err := tx.Select("id", "owner_id").First(&org, "id = ?", organizationID).Error; err != nil {
+			return rowError("organization", organizationID, err)
+		}
+		if org.OwnerID == user.ID {
+			return ErrOrganizationOwner
+		}
+
+		var count int64
+		if err := tx.Model(&entity.OrganizationUser{}).
+			Where("organization_id = ? AND user_id = ?", organizationID, user.ID).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrOrganizationUserExists
+		}
+
+		member = entity.OrganizationUser{
+			OrganizationID: organizationID,
+			UserID:         user.ID,
+			Role:           role,
+		}
+		if err := tx.Create(&member).Error; err != nil {
+			return dbError("organization member", err)
+		}
+		member.User = *user
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.Access.InvalidateMember(organizationID, member.UserID)
+	return &member, nil
+}
+
+func (s *OrganizationUserService) findOrInviteUser(tx *gorm.DB, userID int64, email string) (*entity.User, error) {
+	var user entity.User
+	if userID != 0 {
+		if err := tx.First(&user, "id = ?", userID).Error; err != nil {
+			return nil, rowError("user", userID, err)
+		}
+		return &user, nil
+	}
+
+	err := tx.First(&user, "email = ?", email).Error
+	if err == nil {
+		return &user, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	var role entity.Role
+	if err := tx.Where("name = ?", rbac.RoleUser).First(&role).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrDefaultRoleMissing
+		}
+		return nil, err
+	}
+
+	user = entity.User{
+		Email:  email,
+		RoleID: role.ID,
+	}
+	if err := tx.Create(&user).Error; err != nil {
+		return nil, dbError("user", err)
+	}
+	return &user, nil
+}
+
+func (s *OrganizationUserService) List(ctx context.Context, actorID, organizationID int64, page pagination.Request, lq listquery.Request) (*pagination.Page[entity.OrganizationUser], error) {
+	if _, err := s.Access.Role(ctx, organizationID, actorID); err != nil {
+		return nil, err
+	}
+	q, err := organizationUserFields.Parse(lq)
+	if err != nil {
+		return nil, err
+	}
+
+	query := data.Reader(ctx, s.DB).Model(&entity.OrganizationUser{}).Where("organization_id = ?", organizationID)
+
+	return pagination.Find[entity.OrganizationUser](q.Apply(query), page, q.Order, "User")
+}
+
+func (s *OrganizationUserService) UpdateRole(ctx context.Context, actorID, organizationID, userID int64, role string) (*entity.OrganizationUser, error) {
+	if !entity.IsAssignableOrganizationRole(role) {
+		return nil, ErrInvalidOrganizationRole
+	}
+	if _, err := s.Access.RequireRole(ctx, organizationID, actorID, memberManagerRoles...); err != nil {
+		return nil, err
+	}
+
+	var member entity.OrganizationUser
+	if err := s.DB.WithContext(ctx).Preload("User").
+		Where("organization_id = ? AND user_id = ?", organizationID, userID).
+		First(&member).Error; err != nil {
+		return nil, rowError("organization member", userID, err)
+	}
+
+	member.Role = role
+	if err := s.DB.WithContext(ctx).Model(&member).Update("role", role).Error; err != nil {
+		return nil, err
+	}
+
+	s.Access.InvalidateMember(organizationID, userID)
+	return &member, nil
+}
+
+// Remove deletes a membership. Owners and managers may remove anyone; any
+// member may remove themselves.
+func (s *OrganizationUserService) Remove(ctx context.Context, actorID, organizationID, userID int64) error {
+	if actorID != userID {
+		if _, err := s.Access.RequireRole(ctx, organizationID, actorID, memberManagerRoles...); err != nil {
+			return err
+		}
+	}
+
+	result := s.DB.WithContext(ctx).Where("organization_id = ? AND user_id = ?", organizationID, userID).
+		Delete(&entity.OrganizationUser{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return rowError("organization member", userID, gorm.ErrRecordNotFound)
+	}
+
+	s.Access.InvalidateMember(organizationID, userID)
+	return nil
+}
@@ -3,47 +3,66 @@ package service
 import (
 	"context"
 
+	"persacc/internal/data"
 	"persacc/internal/entity"
+	"persacc/internal/listquery"
+	"persacc/internal/pagination"
 
 	"gorm.io/gorm"
 )
 
+// permissionFields are the fields ListPermissions can filter and order by.
+var permissionFields = listquery.Fields{
+	"id":         {Column: "id", Kind: listquery.Int, Sortable: true},
+	"name":       {Column: "name", Kind: listquery.String, Sortable: true},
+	"created_at": {Column: "created_at", Kind: listquery.Time},
+	"updated_at": {Column: "updated_at", Kind: listquery.Time},
+}
+
 type PermissionService struct {
-	DB *gorm.DB
+	DB        *gorm.DB
+	AuthCache AuthCacheInvalidator
 }
 
-func NewPermissionService(db *gorm.DB) *PermissionService {
-	return &PermissionService{DB: db}
+func NewPermissionService(db *gorm.DB, authCache AuthCacheInvalidator) *PermissionService {
+	return &PermissionService{DB: db, AuthCache: authCacheOrNoop(authCache)}
 }
 
 func (s *PermissionService) Create(ctx context.Context, permission *entity.Permission) error {
-	return s.DB.Create(permission).Error
+	return dbError("permission", s.DB.WithContext(ctx).Create(permission).Error)
 }
 
 func (s *PermissionService) Get(ctx context.Context, id int64) (*entity.Permission, error) {
 	var permission entity.Permission
-	if err := s.DB.First(&permission, "id = ?", id).Error; err != nil {
-		return nil, err
+	if err := data.Reader(ctx, s.DB).First(&permission, "id = ?", id).Error; err != nil {
+		return nil, rowError("permission", id, err)
 	}
 	return &permission, nil
 }
 
-func (s *PermissionService) Update(ctx context.Context, permission *entity.Permission) error {
-	return s.DB.Save(permission).Error
+// Update and Delete affect every role holding the permission, so they drop
+// all cached authentication results.
+func (s *PermissionService) Update(ctx context.Context, before, permission *entity.Permission, version int64) error {
+	if err := data.UpdateChanged(s.DB.WithContext(ctx), before, permission, version); err != nil {
+		return rowError("permission", permission.ID, err)
+	}
+	s.AuthCache.InvalidateAll()
+	return nil
 }
 
-func (s *PermissionService) Delete(ctx context.Context, id int64) error {
-	return s.DB.Delete(&entity.Permission{}, "id = ?", id).Error
+func (s *PermissionService) Delete(ctx context.Context, id int64, version int64) error {
+	if err := data.DeleteVersioned(s.DB.WithContext(ctx), &entity.Permission{}, id, version); err != nil {
+		return rowError("permission", id, err)
+	}
+	s.AuthCache.InvalidateAll()
+	return nil
 }
 
-func (s *PermissionService) List(ctx context.Context, limit, offset int) ([]entity.Permission, int64, error) {
-	var permissions []entity.Permission
-	var total int64
-
-	s.DB.Model(&entity.Permission{}).Count(&total)
-	if err := s.DB.Limit(limit).Offset(offset).Find(&permissions).Error; err != nil {
-		return nil, 0, err
+func (s *PermissionService) List(ctx context.Context, page pagination.Request, lq listquery.Request) (*pagination.Page[entity.Permission], error) {
+	q, err := permissionFields.Parse(lq)
+	if err != nil {
+		return nil, err
 	}
-
-	return permissions, total, nil
+	query := data.Reader(ctx, s.DB).Model(&entity.Permission{})
+	return pagination.Find[entity.Permission](q.Apply(query), page, q.Order)
 }
@@ -2,12 +2,31 @@ package service
 
 import (
 	"context"
+	"errors"
+	"strconv"
 
+	"persacc/internal/data"
 	"persacc/internal/entity"
+	"persacc/internal/listquery"
+	"persacc/internal/pagination"
+	"persacc/internal/sku"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// productFields are the fields ListProducts can filter and order by.
+var productFields = listquery.Fields{
+	"id":          {Column: "id", Kind: listquery.Int, Sortable: true},
+	"name":        {Column: "name", Kind: listquery.String, Sortable: true},
+	"sku":         {Column: "sku", Kind: listquery.String, Sortable: true},
+	"description": {Column: "description", Kind: listquery.String},
+	"category_id": {Column: "category_id", Kind: listquery.Int},
+	"vendor_id":   {Column: "vendor_id", Kind: listquery.Int},
+	"created_at":  {Column: "created_at", Kind: listquery.Time, Sortable: true},
+	"updated_at":  {Column: "updated_at", Kind: listquery.Time, Sortable: true},
+}
+
 type ProductService struct {
 	DB *gorm.DB
 }
@@ -16,40 +35,62 @@ func NewProductService(db *gorm.DB) *ProductService {
 	return &ProductService{DB: db}
 }
 
-func (s *ProductService) Create(ctx context.Context, product *entity.Product) error {
-	return s.DB.Create(product).Error
+// Create adds product. With generateSKU its SKU is the next one of the
+// organization's SKU pattern.
+func (s *ProductService) Create(ctx context.Context, product *entity.Product, generateSKU bool) error {
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkReferences(tx, nil, product); err != nil {
+			return err
+		}
+		if generateSKU {
+			if err := nextSKU(tx, product); err != nil {
+				return err
+			}
+		} else if err := skuConflict(tx, product); err != nil {
+			return err
+		}
+		return tx.Create(product).Error
+	})
+	return s.skuError(ctx, product, dbError("product", err))
 }
 
 func (s *ProductService) Get(ctx context.Context, id int64, organizationID int64) (*entity.Product, error) {
 	var product entity.Product
-	err := s.DB.Preload("ProductDetails").Where("id = ? AND organization_id = ?", id, organizationID).First(&product).Error
+	err := data.Reader(ctx, s.DB).Preload("ProductDetails").Where("id = ? AND organization_id = ?", id, organizationID).First(&product).Error
 	if err != nil {
-		return nil, err
+		return nil, rowError("product", id, err)
 	}
 	return &product, nil
 }
 
-func (s *ProductService) Update(ctx context.Context, product *entity.Product, organizationID int64) error {
-	// Verify relationship exists and it matches organization
-	var count int64
-	s.DB.Model(&entity.Product{}).
-		Where("id = ? AND organization_id = ?", product.ID, organizationID).
-		Count(&count)
-	if count == 0 {
-		return gorm.ErrRecordNotFound
-	}
-	return s.DB.Save(product).Error
+func (s *ProductService) Update(ctx context.Context, before, product *entity.Product, version int64, organizationID int64) error {
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkReferences(tx, before, product); err != nil {
+			return err
+		}
+		if product.SKU != before.SKU {
+			if err := skuConflict(tx, product); err != nil {
+				return err
+			}
+		}
+		if err := data.UpdateChanged(tx.Where("organization_id = ?", organizationID), before, product, version); err != nil {
+			return err
+		}
+		if product.ProductDetails == nil {
+			return nil
+		}
+		product.ProductDetails.ProductID = product.ID
+		return tx.Save(product.ProductDetails).Error
+	})
+	return s.skuError(ctx, product, rowError("product", product.ID, err))
 }
 
-func (s *ProductService) Delete(ctx context.Context, id int64, organizationID int64) error {
-	return s.DB.Where("id = ? AND organization_id = ?", id, organizationID).Delete(&entity.Product{}).Error
+func (s *ProductService) Delete(ctx context.Context, id int64, organizationID int64, version int64) error {
+	return rowError("product", id, data.DeleteVersioned(s.DB.WithContext(ctx).Where("organization_id = ?", organizationID), &entity.Product{}, id, version))
 }
 
-func (s *ProductService) List(ctx context.Context, limit, offset int, organizationID int64, filters map[string]string) ([]entity.Product, int64, error) {
-	var products []entity.Product
-	var total int64
-
-	query := s.DB.Model(&entity.Product{}).Where("organization_id = ?", organizationID)
+func (s *ProductService) List(ctx context.Context, page pagination.Request, lq listquery.Request, organizationID int64, filters map[string]string) (*pagination.Page[entity.Product], error) {
+	query := data.Reader(ctx, s.DB).Model(&entity.Product{}).Where("organization_id = ?", organizationID)
 
 	if name, ok := filters["name"]; ok && name != "" {
 		query = query.Where("name ILIKE ?", "%"+name+"%")
@@ -61,10 +102,104 @@ func (s *ProductService) List(ctx context.Context, limit, offset int, organizati
 		query = query.Where("description ILIKE ?", "%"+description+"%")
 	}
 
-	query.Count(&total)
-	if err := query.Preload("ProductDetails").Limit(limit).Offset(offset).Find(&products).Error; err != nil {
-		return nil, 0, err
+	q, err := productFields.Parse(lq)
+	if err != nil {
+		return nil, err
 	}
+	return pagination.Find[entity.Product](q.Apply(query), page, q.Order, "ProductDetails")
+}
+
+// checkReferences verifies that the category of product belongs to the
+// product's organization and that its vendor exists. References unchanged
+// from before are not checked again, so a product can still be edited after
+// its category was deleted.
+func checkReferences(db *gorm.DB, before, product *entity.Product) error {
+	if product.CategoryID != nil && (before == nil || !sameID(before.CategoryID, product.CategoryID)) {
+		var count int64
+		err := db.Model(&entity.ProductCategory{}).
+			Where("id = ? AND organization_id = ?", *product.CategoryID, product.OrganizationID).
+			Count(&count).Error
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return &ForeignKeyError{Field: "category_id", Value: strconv.FormatInt(*product.CategoryID, 10), Table: "product_categories"}
+		}
+	}
+	if product.VendorID != nil && (before == nil || !sameID(before.VendorID, product.VendorID)) {
+		var count int64
+		if err := db.Model(&entity.Vendor{}).Where("id = ?", *product.VendorID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return &ForeignKeyError{Field: "vendor_id", Value: strconv.FormatInt(*product.VendorID, 10), Table: "vendors"}
+		}
+	}
+	return nil
+}
 
-	return products, total, nil
+func sameID(a, b *int64) bool {
+	return a == b || (a != nil && b != nil && *a == *b)
+}
+
+// maxSKUAttempts bounds the numbers nextSKU skips because their SKU was
+// already entered by hand.
+const maxSKUAttempts = 100
+
+// nextSKU sets the SKU of product to the next free one of its organization's
+// pattern. Taking a number locks the organization's sequence until tx ends,
+// so concurrent creates never get the same SKU.
+func nextSKU(tx *gorm.DB, product *entity.Product) error {
+	for i := 0; i < maxSKUAttempts; i++ {
+		var org entity.Organization
+		result := tx.Model(&org).
+			Clauses(clause.Returning{Columns: []clause.Column{{Name: "sku_pattern"}, {Name: "sku_sequence"}}}).
+			Where("id = ?", product.OrganizationID).
+			UpdateColumn("sku_sequence", gorm.Expr("sku_sequence + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return rowError("organization", product.OrganizationID, gorm.ErrRecordNotFound)
+		}
+		generated, err := sku.Format(org.SKUPattern, org.SKUSequence)
+		if err != nil {
+			return err
+		}
+		product.SKU = generated
+		var conflict *ConflictError
+		if err := skuConflict(tx, product); !errors.As(err, &conflict) {
+			return err
+		}
+	}
+	return &ConflictError{Resource: "product", Field: "sku", Value: product.SKU}
+}
+
+// skuConflict returns a ConflictError naming the product of the same
+// organization that already has the SKU of product, if there is one.
+func skuConflict(db *gorm.DB, product *entity.Product) error {
+	var existing entity.Product
+	err := db.Select("id").
+		Where("organization_id = ? AND sku = ? AND id <> ?", product.OrganizationID, product.SKU, product.ID).
+		Take(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return &ConflictError{Resource: "product", Field: "sku", Value: product.SKU, ID: strconv.FormatInt(existing.ID, 10)}
+}
+
+// skuError names the product that holds the SKU of product when err is a
+// SKU conflict that the unique index caught, such as a concurrent create.
+func (s *ProductService) skuError(ctx context.Context, product *entity.Product, err error) error {
+	var conflict *ConflictError
+	if !errors.As(err, &conflict) || conflict.Field != "sku" || conflict.ID != "" {
+		return err
+	}
+	if named := skuConflict(s.DB.WithContext(ctx), product); named != nil {
+		return named
+	}
+	return err
 }
@@ -3,11 +3,22 @@ package service
 import (
 	"context"
 
+	"persacc/internal/data"
 	"persacc/internal/entity"
+	"persacc/internal/listquery"
+	"persacc/internal/pagination"
 
 	"gorm.io/gorm"
 )
 
+// productCategoryFields are the fields ListProductCategories can filter and order by.
+var productCategoryFields = listquery.Fields{
+	"id":         {Column: "id", Kind: listquery.Int, Sortable: true},
+	"name":       {Column: "name", Kind: listquery.String, Sortable: true},
+	"created_at": {Column: "created_at", Kind: listquery.Time, Sortable: true},
+	"updated_at": {Column: "updated_at", Kind: listquery.Time, Sortable: true},
+}
+
 type ProductCategoryService struct {
 	DB *gorm.DB
 }
@@ -17,48 +28,36 @@ func NewProductCategoryService(db *gorm.DB) *ProductCategoryService {
 }
 
 func (s *ProductCategoryService) Create(ctx context.Context, category *entity.ProductCategory) error {
-	return s.DB.Create(category).Error
+	return dbError("product category", s.DB.WithContext(ctx).Create(category).Error)
 }
 
 func (s *ProductCategoryService) Get(ctx context.Context, id int64, organizationID int64) (*entity.ProductCategory, error) {
 	var category entity.ProductCategory
-	err := s.DB.Where("id = ? AND organization_id = ?", id, organizationID).First(&category).Error
+	err := data.Reader(ctx, s.DB).Where("id = ? AND organization_id = ?", id, organizationID).First(&category).Error
 	if err != nil {
-		return nil, err
+		return nil, rowError("product category", id, err)
 	}
 	return &category, nil
 }
 
-func (s *ProductCategoryService) Update(ctx context.Context, category *entity.ProductCategory, organizationID int64) error {
-	// Verify relationship exists and it matches organization
-	var count int64
-	s.DB.Model(&entity.ProductCategory{}).
-		Where("id = ? AND organization_id = ?", category.ID, organizationID).
-		Count(&count)
-	if count == 0 {
-		return gorm.ErrRecordNotFound
-	}
-	return s.DB.Save(category).Error
+func (s *ProductCategoryService) Update(ctx context.Context, before, category *entity.ProductCategory, version int64, organizationID int64) error {
+	return rowError("product category", category.ID, data.UpdateChanged(s.DB.WithContext(ctx).Where("organization_id = ?", organizationID), before, category, version))
 }
 
-func (s *ProductCategoryService) Delete(ctx context.Context, id int64, organizationID int64) error {
-	return s.DB.Where("id = ? AND organization_id = ?", id, organizationID).Delete(&entity.ProductCategory{}).Error
+func (s *ProductCategoryService) Delete(ctx context.Context, id int64, organizationID int64, version int64) error {
+	return rowError("product category", id, data.DeleteVersioned(s.DB.WithContext(ctx).Where("organization_id = ?", organizationID), &entity.ProductCategory{}, id, version))
 }
 
-func (s *ProductCategoryService) List(ctx context.Context, limit, offset int, organizationID int64, filters map[string]string) ([]entity.ProductCategory, int64, error) {
-	var categories []entity.ProductCategory
-	var total int64
-
-	query := s.DB.Model(&entity.ProductCategory{}).Where("organization_id = ?", organizationID)
+func (s *ProductCategoryService) List(ctx context.Context, page pagination.Request, lq listquery.Request, organizationID int64, filters map[string]string) (*pagination.Page[entity.ProductCategory], error) {
+	query := data.Reader(ctx, s.DB).Model(&entity.ProductCategory{}).Where("organization_id = ?", organizationID)
 
 	if name, ok := filters["name"]; ok && name != "" {
 		query = query.Where("name ILIKE ?", "%"+name+"%")
 	}
 
-	query.Count(&total)
-	if err := query.Limit(limit).Offset(offset).Find(&categories).Error; err != nil {
-		return nil, 0, err
+	q, err := productCategoryFields.Parse(lq)
+	if err != nil {
+		return nil, err
 	}
-
-	return categories, total, nil
+	return pagination.Find[entity.ProductCategory](q.Apply(query), page, q.Order)
 }
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"persacc/internal/data/datatest"
+	"persacc/internal/entity"
+
+	"gorm.io/gorm"
+)
+
+func TestCheckReferences(t *testing.T) {
+	db, rec := datatest.DryRun(t)
+	category := int64(5)
+	product := &entity.Product{OrganizationID: 1, CategoryID: &category}
+
+	// A dry run counts no rows, so every reference is missing
+	err := checkReferences(db, nil, product)
+	var fk *ForeignKeyError
+	if !errors.As(err, &fk) || fk.Field != "category_id" {
+		t.Fatalf("got %v, want a ForeignKeyError for category_id", err)
+	}
+	if got := rec.Statements[0]; !strings.Contains(got, "organization_id = 1") {
+		t.Errorf("category lookup %q is not scoped to the organization", got)
+	}
+
+	rec.Statements = nil
+	before := *product
+	if err := checkReferences(db, &before, product); err != nil || len(rec.Statements) != 0 {
+		t.Errorf("an unchanged category was checked again: %v %q", err, rec.Statements)
+	}
+}
+
+func TestDBErrorCompositeForeignKey(t *testing.T) {
+	err := dbError("product", pgError(pgForeignKeyViolation,
+		`Key (organization_id, category_id)=(1, 9) is not present in table "product_categories".`))
+	var fk *ForeignKeyError
+	if !errors.As(err, &fk) || fk.Field != "category_id" || fk.Value != "9" {
+		t.Errorf("foreign key violation = %#v", err)
+	}
+}
+
+func TestNextSKUTakesSequenceNumber(t *testing.T) {
+	db, rec := datatest.DryRun(t)
+	product := &entity.Product{OrganizationID: 3}
+
+	// A dry run updates no organization
+	err := nextSKU(db, product)
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("got %v, want the organization not found", err)
+	}
+	got := rec.Statements[0]
+	for _, want := range []string{`"sku_sequence"=sku_sequence + 1`, `id = 3`, `RETURNING "sku_pattern","sku_sequence"`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("update %q lacks %q", got, want)
+		}
+	}
+}
+
+func TestSKUConflictQuery(t *testing.T) {
+	db, rec := datatest.DryRun(t)
+	_ = skuConflict(db, &entity.Product{ID: 4, OrganizationID: 3, SKU: "CH-1"})
+	got := rec.Statements[0]
+	for _, want := range []string{`organization_id = 3`, `sku = 'CH-1'`, `id <> 4`, `"deleted_at" IS NULL`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("lookup %q lacks %q", got, want)
+		}
+	}
+}
@@ -3,68 +3,87 @@ package service
 import (
 	"context"
 
+	"persacc/internal/data"
 	"persacc/internal/entity"
+	"persacc/internal/listquery"
+	"persacc/internal/pagination"
 
 	"gorm.io/gorm"
 )
 
+// roleFields are the fields ListRoles can filter and order by.
+var roleFields = listquery.Fields{
+	"id":         {Column: "id", Kind: listquery.Int, Sortable: true},
+	"name":       {Column: "name", Kind: listquery.String, Sortable: true},
+	"created_at": {Column: "created_at", Kind: listquery.Time},
+	"updated_at": {Column: "updated_at", Kind: listquery.Time},
+}
+
 type RoleService struct {
-	DB *gorm.DB
+	DB        *gorm.DB
+	AuthCache AuthCacheInvalidator
 }
 
-func NewRoleService(db *gorm.DB) *RoleService {
-	return &RoleService{DB: db}
+func NewRoleService(db *gorm.DB, authCache AuthCacheInvalidator) *RoleService {
+	return &RoleService{DB: db, AuthCache: authCacheOrNoop(authCache)}
 }
 
 func (s *RoleService) Create(ctx context.Context, role *entity.Role, permissionIDs []int64) error {
 	if len(permissionIDs) > 0 {
 		var perms []entity.Permission
-		if err := s.DB.Find(&perms, permissionIDs).Error; err != nil {
+		if err := s.DB.WithContext(ctx).Find(&perms, permissionIDs).Error; err != nil {
 			return err
 		}
 		role.Permissions = perms
 	}
-	return s.DB.Create(role).Error
+	return dbError("role", s.DB.WithContext(ctx).Create(role).Error)
 }
 
 func (s *RoleService) Get(ctx context.Context, id int64) (*entity.Role, error) {
 	var role entity.Role
-	if err := s.DB.Preload("Permissions").First(&role, "id = ?", id).Error; err != nil {
-		return nil, err
+	if err := data.Reader(ctx, s.DB).Preload("Permissions").First(&role, "id = ?", id).Error; err != nil {
+		return nil, rowError("role", id, err)
 	}
 	return &role, nil
 }
 
-func (s *RoleService) Update(ctx context.Context, role *entity.Role, permissionIDs []int64, updatePerms bool) error {
-	if updatePerms {
-		var perms []entity.Permission
-		if len(permissionIDs) > 0 {
-			if err := s.DB.Find(&perms, permissionIDs).Error; err != nil {
-				return err
+func (s *RoleService) Update(ctx context.Context, before, role *entity.Role, version int64, permissionIDs []int64, updatePerms bool) error {
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if updatePerms {
+			var perms []entity.Permission
+			if len(permissionIDs) > 0 {
+				if err := tx.Find(&perms, permissionIDs).Error; err != nil {
+					return err
+				}
 			}
-		}
 
-		if err := s.DB.Model(role).Association("Permissions").Replace(perms); err != nil {
-			return err
+			if err := tx.Model(role).Association("Permissions").Replace(perms); err != nil {
+				return err
+			}
+			role.Permissions = perms
 		}
-		role.Permissions = perms
+		return rowError("role", role.ID, data.UpdateChanged(tx, before, role, version))
+	})
+	if err != nil {
+		return err
 	}
-
-	return s.DB.Save(role).Error
+	s.AuthCache.InvalidateRole(role.ID)
+	return nil
 }
 
-func (s *RoleService) Delete(ctx context.Context, id int64) error {
-	return s.DB.Delete(&entity.Role{}, "id = ?", id).Error
+func (s *RoleService) Delete(ctx context.Context, id int64, version int64) error {
+	if err := data.DeleteVersioned(s.DB.WithContext(ctx), &entity.Role{}, id, version); err != nil {
+		return rowError("role", id, err)
+	}
+	s.AuthCache.InvalidateRole(id)
+	return nil
 }
 
-func (s *RoleService) List(ctx context.Context, limit, offset int) ([]entity.Role, int64, error) {
-	var roles []entity.Role
-	var total int64
-
-	s.DB.Model(&entity.Role{}).Count(&total)
-	if err := s.DB.Preload("Permissions").Limit(limit).Offset(offset).Find(&roles).Error; err != nil {
-		return nil, 0, err
+func (s *RoleService) List(ctx context.Context, page pagination.Request, lq listquery.Request) (*pagination.Page[entity.Role], error) {
+	query := data.Reader(ctx, s.DB).Model(&entity.Role{})
+	q, err := roleFields.Parse(lq)
+	if err != nil {
+		return nil, err
 	}
-
-	return roles, total, nil
+	return pagination.Find[entity.Role](q.Apply(query), page, q.Order, "Permissions")
 }
@@ -3,11 +3,24 @@ package service
 import (
 	"context"
 
+	"persacc/internal/data"
 	"persacc/internal/entity"
+	"persacc/internal/listquery"
+	"persacc/internal/pagination"
 
 	"gorm.io/gorm"
 )
 
+// supplierFields are the fields ListSuppliers can filter and order by.
+var supplierFields = listquery.Fields{
+	"id":         {Column: "id", Kind: listquery.Int, Sortable: true},
+	"name":       {Column: "name", Kind: listquery.String, Sortable: true},
+	"domain":     {Column: "domain", Kind: listquery.String},
+	"phone":      {Column: "phone", Kind: listquery.String},
+	"created_at": {Column: "created_at", Kind: listquery.Time, Sortable: true},
+	"updated_at": {Column: "updated_at", Kind: listquery.Time, Sortable: true},
+}
+
 type SupplierService struct {
 	DB *gorm.DB
 }
@@ -17,48 +30,36 @@ func NewSupplierService(db *gorm.DB) *SupplierService {
 }
 
 func (s *SupplierService) Create(ctx context.Context, supplier *entity.Supplier) error {
-	return s.DB.Create(supplier).Error
+	return dbError("supplier", s.DB.WithContext(ctx).Create(supplier).Error)
 }
 
 func (s *SupplierService) Get(ctx context.Context, id int64, organizationID int64) (*entity.Supplier, error) {
 	var supplier entity.Supplier
-	err := s.DB.Where("id = ? AND organization_id = ?", id, organizationID).First(&supplier).Error
+	err := data.Reader(ctx, s.DB).Where("id = ? AND organization_id = ?", id, organizationID).First(&supplier).Error
 	if err != nil {
-		return nil, err
+		return nil, rowError("supplier", id, err)
 	}
 	return &supplier, nil
 }
 
-func (s *SupplierService) Update(ctx context.Context, supplier *entity.Supplier, organizationID int64) error {
-	// Verify relationship exists and it matches organization
-	var count int64
-	s.DB.Model(&entity.Supplier{}).
-		Where("id = ? AND organization_id = ?", supplier.ID, organizationID).
-		Count(&count)
-	if count == 0 {
-		return gorm.ErrRecordNotFound
-	}
-	return s.DB.Save(supplier).Error
+func (s *SupplierService) Update(ctx context.Context, before, supplier *entity.Supplier, version int64, organizationID int64) error {
+	return rowError("supplier", supplier.ID, data.UpdateChanged(s.DB.WithContext(ctx).Where("organization_id = ?", organizationID), before, supplier, version))
 }
 
-func (s *SupplierService) Delete(ctx context.Context, id int64, organizationID int64) error {
-	return s.DB.Where("id = ? AND organization_id = ?", id, organizationID).Delete(&entity.Supplier{}).Error
+func (s *SupplierService) Delete(ctx context.Context, id int64, organizationID int64, version int64) error {
+	return rowError("supplier", id, data.DeleteVersioned(s.DB.WithContext(ctx).Where("organization_id = ?", organizationID), &entity.Supplier{}, id, version))
 }
 
-func (s *SupplierService) List(ctx context.Context, limit, offset int, organizationID int64, filters map[string]string) ([]entity.Supplier, int64, error) {
-	var suppliers []entity.Supplier
-	var total int64
-
-	query := s.DB.Model(&entity.Supplier{}).Where("organization_id = ?", organizationID)
+func (s *SupplierService) List(ctx context.Context, page pagination.Request, lq listquery.Request, organizationID int64, filters map[string]string) (*pagination.Page[entity.Supplier], error) {
+	query := data.Reader(ctx, s.DB).Model(&entity.Supplier{}).Where("organization_id = ?", organizationID)
 
 	if name, ok := filters["name"]; ok && name != "" {
 		query = query.Where("name ILIKE ?", "%"+name+"%")
 	}
 
-	query.Count(&total)
-	if err := query.Limit(limit).Offset(offset).Find(&suppliers).Error; err != nil {
-		return nil, 0, err
+	q, err := supplierFields.Parse(lq)
+	if err != nil {
+		return nil, err
 	}
-
-	return suppliers, total, nil
+	return pagination.Find[entity.Supplier](q.Apply(query), page, q.Order)
 }
@@ -3,66 +3,91 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"persacc/internal/data"
 	"persacc/internal/entity"
+	"persacc/internal/listquery"
+	"persacc/internal/pagination"
+	"persacc/internal/rbac"
 
 	"gorm.io/gorm"
 )
 
+// userFields are the fields ListUsers can filter and order by.
+var userFields = listquery.Fields{
+	"id":         {Column: "id", Kind: listquery.Int, Sortable: true},
+	"name":       {Column: "name", Kind: listquery.String},
+	"email":      {Column: "email", Kind: listquery.String, Sortable: true},
+	"role_id":    {Column: "role_id", Kind: listquery.Int},
+	"created_at": {Column: "created_at", Kind: listquery.Time},
+	"updated_at": {Column: "updated_at", Kind: listquery.Time},
+}
+
 type UserService struct {
-	DB *gorm.DB
+	DB        *gorm.DB
+	AuthCache AuthCacheInvalidator
 }
 
-func NewUserService(db *gorm.DB) *UserService {
-	return &UserService{DB: db}
+func NewUserService(db *gorm.DB, authCache AuthCacheInvalidator) *UserService {
+	return &UserService{DB: db, AuthCache: authCacheOrNoop(authCache)}
 }
 
 func (s *UserService) Create(ctx context.Context, user *entity.User) error {
-	return s.DB.Create(user).Error
+	return dbError("user", s.DB.WithContext(ctx).Create(user).Error)
 }
 
 func (s *UserService) Get(ctx context.Context, id int64) (*entity.User, error) {
 	var user entity.User
-	if err := s.DB.First(&user, "id = ?", id).Error; err != nil {
-		return nil, err
+	if err := data.Reader(ctx, s.DB).First(&user, "id = ?", id).Error; err != nil {
+		return nil, rowError("user", id, err)
 	}
 	return &user, nil
 }
 
-func (s *UserService) Update(ctx context.Context, user *entity.User) error {
-	return s.DB.Save(user).Error
+func (s *UserService) Update(ctx context.Context, before, user *entity.User, version int64) error {
+	if err := data.UpdateChanged(s.DB.WithContext(ctx), before, user, version); err != nil {
+		return rowError("user", user.ID, err)
+	}
+	s.AuthCache.InvalidateUser(user.ID)
+	return nil
 }
 
-func (s *UserService) Delete(ctx context.Context, id int64) error {
-	return s.DB.Delete(&entity.User{}, "id = ?", id).Error
+func (s *UserService) Delete(ctx context.Context, id int64, version int64) error {
+	if err := data.DeleteVersioned(s.DB.WithContext(ctx), &entity.User{}, id, version); err != nil {
+		return rowError("user", id, err)
+	}
+	s.AuthCache.InvalidateUser(id)
+	return nil
 }
 
-func (s *UserService) List(ctx context.Context, limit, offset int) ([]entity.User, int64, error) {
-	var users []entity.User
-	var total int64
-
-	s.DB.Model(&entity.User{}).Count(&total)
-	if err := s.DB.Limit(limit).Offset(offset).Find(&users).Error; err != nil {
-		return nil, 0, err
+func (s *UserService) List(ctx context.Context, page pagination.Request, lq listquery.Request) (*pagination.Page[entity.User], error) {
+	query := data.Reader(ctx, s.DB).Model(&entity.User{})
+	q, err := userFields.Parse(lq)
+	if err != nil {
+		return nil, err
 	}
-
-	return users, total, nil
+	return pagination.Find[entity.User](q.Apply(query), page, q.Order)
 }
 
+// ErrDefaultRoleMissing means the role given to new users does not exist
+// because the bootstrap has not run.
+var ErrDefaultRoleMissing = fmt.Errorf("default %q role not found, run the bootstrap", rbac.RoleUser)
+
 func (s *UserService) Register(ctx context.Context, email, name string) (*entity.User, error) {
 	// Check if user already exists
 	var existingUser entity.User
-	if err := s.DB.Where("email = ?", email).First(&existingUser).Error; err == nil {
-		return nil, errors.New("user with this email already exists")
+	if err := s.DB.WithContext(ctx).Where("email = ?", email).First(&existingUser).Error; err == nil {
+		return nil, &ConflictError{Resource: "user", Field: "email", Value: email}
 	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, err
 	}
 
 	// Find the 'user' role
 	var role entity.Role
-	if err := s.DB.Where("name = ?", "user").First(&role).Error; err != nil {
+	if err := s.DB.WithContext(ctx).Where("name = ?", rbac.RoleUser).First(&role).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("'user' role not found in the system")
+			return nil, ErrDefaultRoleMissing
 		}
 		return nil, err
 	}
@@ -73,8 +98,8 @@ func (s *UserService) Register(ctx context.Context, email, name string) (*entity
 		RoleID: role.ID,
 	}
 
-	if err := s.DB.Create(&user).Error; err != nil {
-		return nil, err
+	if err := s.DB.WithContext(ctx).Create(&user).Error; err != nil {
+		return nil, dbError("user", err)
 	}
 
 	return &user, nil
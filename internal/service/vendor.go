@@ -3,11 +3,23 @@ package service
 import (
 	"context"
 
+	"persacc/internal/data"
 	"persacc/internal/entity"
+	"persacc/internal/listquery"
+	"persacc/internal/pagination"
 
 	"gorm.io/gorm"
 )
 
+// vendorFields are the fields ListVendors can filter and order by.
+var vendorFields = listquery.Fields{
+	"id":         {Column: "id", Kind: listquery.Int, Sortable: true},
+	"name":       {Column: "name", Kind: listquery.String, Sortable: true},
+	"domain":     {Column: "domain", Kind: listquery.String},
+	"created_at": {Column: "created_at", Kind: listquery.Time, Sortable: true},
+	"updated_at": {Column: "updated_at", Kind: listquery.Time, Sortable: true},
+}
+
 type VendorService struct {
 	DB *gorm.DB
 }
@@ -17,40 +29,36 @@ func NewVendorService(db *gorm.DB) *VendorService {
 }
 
 func (s *VendorService) Create(ctx context.Context, vendor *entity.Vendor) error {
-	return s.DB.Create(vendor).Error
+	return dbError("vendor", s.DB.WithContext(ctx).Create(vendor).Error)
 }
 
 func (s *VendorService) Get(ctx context.Context, id int64) (*entity.Vendor, error) {
 	var vendor entity.Vendor
-	err := s.DB.First(&vendor, id).Error
+	err := data.Reader(ctx, s.DB).First(&vendor, id).Error
 	if err != nil {
-		return nil, err
+		return nil, rowError("vendor", id, err)
 	}
 	return &vendor, nil
 }
 
-func (s *VendorService) Update(ctx context.Context, vendor *entity.Vendor) error {
-	return s.DB.Save(vendor).Error
+func (s *VendorService) Update(ctx context.Context, before, vendor *entity.Vendor, version int64) error {
+	return rowError("vendor", vendor.ID, data.UpdateChanged(s.DB.WithContext(ctx), before, vendor, version))
 }
 
-func (s *VendorService) Delete(ctx context.Context, id int64) error {
-	return s.DB.Delete(&entity.Vendor{}, id).Error
+func (s *VendorService) Delete(ctx context.Context, id int64, version int64) error {
+	return rowError("vendor", id, data.DeleteVersioned(s.DB.WithContext(ctx), &entity.Vendor{}, id, version))
 }
 
-func (s *VendorService) List(ctx context.Context, limit, offset int, filters map[string]string) ([]entity.Vendor, int64, error) {
-	var vendors []entity.Vendor
-	var total int64
-
-	query := s.DB.Model(&entity.Vendor{})
+func (s *VendorService) List(ctx context.Context, page pagination.Request, lq listquery.Request, filters map[string]string) (*pagination.Page[entity.Vendor], error) {
+	query := data.Reader(ctx, s.DB).Model(&entity.Vendor{})
 
 	if name, ok := filters["name"]; ok && name != "" {
 		query = query.Where("name ILIKE ?", "%"+name+"%")
 	}
 
-	query.Count(&total)
-	if err := query.Limit(limit).Offset(offset).Find(&vendors).Error; err != nil {
-		return nil, 0, err
+	q, err := vendorFields.Parse(lq)
+	if err != nil {
+		return nil, err
 	}
-
-	return vendors, total, nil
+	return pagination.Find[entity.Vendor](q.Apply(query), page, q.Order)
 }
@@ -0,0 +1,74 @@
+// Package sku generates product SKUs from an organization's pattern and
+// sequence.
+//
+// A pattern is literal text with a single {seq} placeholder for the next
+// number of the sequence; {seq:N} pads the number with zeros to N digits.
+// For example PRD-{seq:5} gives PRD-00001, PRD-00002 and so on.
+package sku
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"unicode/utf8"
+)
+
+// DefaultPattern is used by organizations that have not set one.
+const DefaultPattern = "SKU-{seq:6}"
+
+const (
+	// maxWidth bounds the padding of {seq:N}.
+	maxWidth = 20
+	// maxDigits is the length of the largest sequence number.
+	maxDigits = 19
+	// MaxLength is the length of the products.sku column.
+	MaxLength = 255
+)
+
+var placeholder = regexp.MustCompile(`\{seq(?::(\d+))?\}`)
+
+// ErrInvalidPattern is returned for patterns without exactly one {seq}.
+var ErrInvalidPattern = errors.New("must contain {seq} or {seq:N} exactly once, with N at most 20")
+
+// ErrPatternTooLong is returned for patterns whose SKUs may not fit the
+// column once {seq} is expanded.
+var ErrPatternTooLong = fmt.Errorf("may generate SKUs longer than %d characters", MaxLength)
+
+// Validate reports whether pattern can generate SKUs that fit the column.
+func Validate(pattern string) error {
+	m := placeholder.FindAllStringSubmatch(pattern, -1)
+	if len(m) != 1 {
+		return ErrInvalidPattern
+	}
+	width := maxDigits
+	if m[0][1] != "" {
+		w, err := strconv.Atoi(m[0][1])
+		if err != nil || w > maxWidth {
+			return ErrInvalidPattern
+		}
+		width = max(w, maxDigits)
+	}
+	if utf8.RuneCountInString(pattern)-utf8.RuneCountInString(m[0][0])+width > MaxLength {
+		return ErrPatternTooLong
+	}
+	return nil
+}
+
+// Format returns the SKU for number n of pattern; an empty pattern stands
+// for DefaultPattern.
+func Format(pattern string, n int64) (string, error) {
+	if pattern == "" {
+		pattern = DefaultPattern
+	}
+	if err := Validate(pattern); err != nil {
+		return "", err
+	}
+	return placeholder.ReplaceAllStringFunc(pattern, func(p string) string {
+		width := placeholder.FindStringSubmatch(p)[1]
+		if width == "" {
+			return strconv.FormatInt(n, 10)
+		}
+		return fmt.Sprintf("%0"+width+"d", n)
+	}), nil
+}
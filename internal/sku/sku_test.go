@@ -0,0 +1,52 @@
+package sku
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		pattern string
+		n       int64
+		want    string
+	}{
+		{"", 7, "SKU-000007"},
+		{"PRD-{seq:3}", 42, "PRD-042"},
+		{"PRD-{seq:3}", 12345, "PRD-12345"},
+		{"{seq}-CH", 9, "9-CH"},
+	}
+	for _, tt := range tests {
+		got, err := Format(tt.pattern, tt.n)
+		if err != nil || got != tt.want {
+			t.Errorf("Format(%q, %d) = %q, %v, want %q", tt.pattern, tt.n, got, err, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, pattern := range []string{"PRD", "{seq}-{seq}", "PRD-{seq:99}"} {
+		if Validate(pattern) == nil {
+			t.Errorf("Validate(%q) accepted an invalid pattern", pattern)
+		}
+	}
+}
+
+func TestValidateExpandedLength(t *testing.T) {
+	// The literal text plus the widest number must fit the column
+	fits := strings.Repeat("x", MaxLength-maxWidth) + "{seq:20}"
+	if err := Validate(fits); err != nil {
+		t.Errorf("Validate rejected a pattern of %d characters: %v", len(fits), err)
+	}
+	for _, pattern := range []string{
+		strings.Repeat("x", MaxLength-maxWidth+1) + "{seq:20}",
+		strings.Repeat("x", MaxLength-maxDigits+1) + "{seq}",
+	} {
+		if err := Validate(pattern); err != ErrPatternTooLong {
+			t.Errorf("Validate(%d characters) = %v, want ErrPatternTooLong", len(pattern), err)
+		}
+	}
+	if got, _ := Format(fits, 1); len(got) != MaxLength {
+		t.Errorf("Format expanded to %d characters, want %d", len(got), MaxLength)
+	}
+}
@@ -0,0 +1,157 @@
+// Package tlsutil builds the TLS configurations of the public listener and
+// the auth service client. Certificates are read through a CertReloader so
+// that renewed files are picked up without a restart.
+package tlsutil
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// CertReloader serves a certificate and key pair from disk, loading it
+// again when either file changes.
+type CertReloader struct {
+	CertFile string
+	KeyFile  string
+
+	mu     sync.RWMutex
+	cert   *tls.Certificate
+	loaded fileStamp
+}
+
+// fileStamp identifies a version of the certificate and key files.
+type fileStamp struct {
+	certMod, certSize int64
+	keyMod, keySize   int64
+}
+
+// NewCertReloader loads the pair once; call Watch to keep it current.
+func NewCertReloader(certFile, keyFile string) (*CertReloader, error) {
+	r := &CertReloader{CertFile: certFile, KeyFile: keyFile}
+	if _, err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload loads the pair if either file changed since the last load and
+// reports whether it did. A pair that fails to load, for instance while
+// only one of the files has been replaced, keeps the current certificate.
+func (r *CertReloader) Reload() (bool, error) {
+	stamp, err := r.stamp()
+	if err != nil {
+		return false, err
+	}
+	r.mu.RLock()
+	unchanged := r.cert != nil && stamp == r.loaded
+	r.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.CertFile, r.KeyFile)
+	if err != nil {
+		return false, fmt.Errorf("failed to load certificate %s: %w", r.CertFile, err)
+	}
+	r.mu.Lock()
+	r.cert = &cert
+	r.loaded = stamp
+	r.mu.Unlock()
+	return true, nil
+}
+
+func (r *CertReloader) stamp() (fileStamp, error) {
+	cert, err := os.Stat(r.CertFile)
+	if err != nil {
+		return fileStamp{}, err
+	}
+	key, err := os.Stat(r.KeyFile)
+	if err != nil {
+		return fileStamp{}, err
+	}
+	return fileStamp{
+		certMod:  cert.ModTime().UnixNano(),
+		certSize: cert.Size(),
+		keyMod:   key.ModTime().UnixNano(),
+		keySize:  key.Size(),
+	}, nil
+}
+
+// Watch checks the files every interval until ctx is done.
+func (r *CertReloader) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			changed, err := r.Reload()
+			if err != nil {
+				slog.Warn("failed to reload certificate", "cert_file", r.CertFile, "error", err)
+				continue
+			}
+			if changed {
+				slog.Info("certificate reloaded", "cert_file", r.CertFile, "not_after", r.Certificate().Leaf.NotAfter)
+			}
+		}
+	}
+}
+
+// Certificate returns the pair loaded last.
+func (r *CertReloader) Certificate() *tls.Certificate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert
+}
+
+// GetCertificate is used as tls.Config.GetCertificate by servers.
+func (r *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return r.Certificate(), nil
+}
+
+// GetClientCertificate is used as tls.Config.GetClientCertificate by
+// clients authenticating with mutual TLS.
+func (r *CertReloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return r.Certificate(), nil
+}
+
+// ServerConfig returns the listener configuration presenting cert.
+func ServerConfig(cert *CertReloader) *tls.Config {
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: cert.GetCertificate,
+	}
+}
+
+// ClientConfig returns a client configuration that trusts the certificates
+// in caFile, or the system roots when caFile is empty, and presents cert
+// when it is not nil. serverName overrides the name checked against the
+// server's certificate.
+func ClientConfig(caFile, serverName string, cert *CertReloader) (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serverName,
+	}
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", caFile)
+		}
+		cfg.RootCAs = pool
+	}
+	if cert != nil {
+		cfg.GetClientCertificate = cert.GetClientCertificate
+	}
+	return cfg, nil
+}
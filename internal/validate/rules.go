@@ -0,0 +1,119 @@
+package validate
+
+import (
+	"persacc/internal/entity"
+	"persacc/internal/pagination"
+	"persacc/internal/sku"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// maxName is the length of the varchar(255) columns.
+const maxName = 255
+
+var (
+	id      = Rule{Field: "id", Presence: Required, Checks: []Check{Min(1)}}
+	page    = Rule{Field: "page", Checks: []Check{Min(1)}}
+	limit   = Rule{Field: "limit", Checks: []Check{Min(1), Max(pagination.MaxLimit)}}
+	version = Rule{Field: "version", Presence: Required, Checks: []Check{Min(1)}}
+
+	organizationID = Rule{Field: "organization_id", Presence: Required, Checks: []Check{Min(1)}}
+	orgRole        = Rule{Field: "role", Presence: Required, Checks: []Check{OneOf(
+		entity.OrganizationRoleManager, entity.OrganizationRoleAccountant, entity.OrganizationRoleViewer)}}
+)
+
+// text is an optional varchar(255) column.
+func text(field string) Rule {
+	return Rule{Field: field, Checks: []Check{MaxLength(maxName)}}
+}
+
+// name is a varchar(255) column that may not be empty: required on Create
+// and, on Update, whenever the update_mask lists it.
+func name(field string, presence Presence) Rule {
+	return Rule{Field: field, Presence: presence, Checks: []Check{MaxLength(maxName)}}
+}
+
+// skuPattern accepts the patterns sku.Format can expand.
+func skuPattern(v protoreflect.Value) string {
+	if err := sku.Validate(v.String()); err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+var skuPatternRule = Rule{Field: "sku_pattern", Checks: []Check{MaxLength(maxName), skuPattern}}
+
+var messageRules = map[protoreflect.FullName][]Rule{
+	"admin.CreateUserRequest": {text("name"), {Field: "email", Presence: Required, Checks: []Check{Email}}},
+	"admin.GetUserRequest":    {id},
+	"admin.UpdateUserRequest": {id, text("name"), {Field: "email", Presence: RequiredInMask, Checks: []Check{Email}}, version},
+	"admin.DeleteUserRequest": {id, version},
+	"admin.ListUsersRequest":  {page, limit},
+
+	"admin.CreateRoleRequest": {name("name", Required)},
+	"admin.GetRoleRequest":    {id},
+	"admin.UpdateRoleRequest": {id, name("name", RequiredInMask), version},
+	"admin.DeleteRoleRequest": {id, version},
+	"admin.ListRolesRequest":  {page, limit},
+
+	"admin.CreatePermissionRequest": {name("name", Required)},
+	"admin.GetPermissionRequest":    {id},
+	"admin.UpdatePermissionRequest": {id, name("name", RequiredInMask), version},
+	"admin.DeletePermissionRequest": {id, version},
+	"admin.ListPermissionsRequest":  {page, limit},
+
+	"admin.CreateOrganizationRequest": {{Field: "owner_id", Checks: []Check{Min(1)}}, name("name", Required), skuPatternRule},
+	"admin.GetOrganizationRequest":    {id},
+	"admin.UpdateOrganizationRequest": {id, name("name", RequiredInMask), skuPatternRule, version},
+	"admin.DeleteOrganizationRequest": {id, version},
+	"admin.ListOrganizationsRequest":  {page, limit},
+
+	"admin.AddOrganizationUserRequest":        {organizationID, {Field: "email", Checks: []Check{Email}}, orgRole},
+	"admin.ListOrganizationUsersRequest":      {organizationID, page, limit},
+	"admin.UpdateOrganizationUserRoleRequest": {organizationID, {Field: "user_id", Presence: Required}, orgRole},
+	"admin.RemoveOrganizationUserRequest":     {organizationID, {Field: "user_id", Presence: Required}},
+
+	"admin.CreateCustomerRequest": {
+		name("name", Required), text("first_name"), text("last_name"), text("prefix"), text("middle_name"), text("suffix"),
+		{Field: "birthday", Checks: []Check{Date}},
+		{Field: "phone", Checks: []Check{Phone}},
+		{Field: "email", Checks: []Check{MaxLength(maxName), Email}},
+	},
+	"admin.GetCustomerRequest": {id},
+	"admin.UpdateCustomerRequest": {
+		id, name("name", RequiredInMask), text("first_name"), text("last_name"), text("prefix"), text("middle_name"), text("suffix"),
+		{Field: "birthday", Checks: []Check{Date}},
+		{Field: "phone", Checks: []Check{Phone}},
+		{Field: "email", Checks: []Check{MaxLength(maxName), Email}},
+		version,
+	},
+	"admin.DeleteCustomerRequest": {id, version},
+	"admin.ListCustomersRequest":  {page, limit},
+
+	"admin.CreateProductRequest": {
+		{Field: "sku", Presence: Required, Unless: "generate_sku", Checks: []Check{MaxLength(maxName)}},
+		name("name", Required), text("vendor_product_code"),
+	},
+	"admin.GetProductRequest":    {id},
+	"admin.UpdateProductRequest": {id, name("sku", RequiredInMask), name("name", RequiredInMask), text("vendor_product_code"), version},
+	"admin.DeleteProductRequest": {id, version},
+	"admin.ListProductsRequest":  {page, limit},
+
+	"admin.CreateProductCategoryRequest": {name("name", Required)},
+	"admin.GetProductCategoryRequest":    {id},
+	"admin.UpdateProductCategoryRequest": {id, name("name", RequiredInMask), version},
+	"admin.DeleteProductCategoryRequest": {id, version},
+	"admin.ListProductCategoriesRequest": {page, limit},
+
+	"admin.CreateVendorRequest": {name("name", Required), text("domain")},
+	"admin.GetVendorRequest":    {id},
+	"admin.UpdateVendorRequest": {id, name("name", RequiredInMask), text("domain"), version},
+	"admin.DeleteVendorRequest": {id, version},
+	"admin.ListVendorsRequest":  {page, limit},
+
+	"admin.CreateSupplierRequest": {name("name", Required), text("domain"), {Field: "phone", Checks: []Check{Phone}}},
+	"admin.GetSupplierRequest":    {id},
+	"admin.UpdateSupplierRequest": {id, name("name", RequiredInMask), text("domain"), {Field: "phone", Checks: []Check{Phone}}, version},
+	"admin.DeleteSupplierRequest": {id, version},
+	"admin.ListSuppliersRequest":  {page, limit},
+}
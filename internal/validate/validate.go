@@ -0,0 +1,185 @@
+// Package validate checks request messages against the declarative rules
+// of their type before they reach the controllers.
+//
+// Fields left at their zero value count as unset. Checks only run on set
+// fields, so an optional email may be empty but not malformed. On Update
+// requests an empty field usually means "leave unchanged"; a field listed
+// in the update_mask is written even when empty, so RequiredInMask rejects
+// clearing a column that may not be empty.
+package validate
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Presence says when a field must be set.
+type Presence int
+
+const (
+	Optional Presence = iota
+	// Required fields must always be set.
+	Required
+	// RequiredInMask fields must be set when the update_mask lists them.
+	RequiredInMask
+)
+
+// Check describes what is wrong with the value of a set field, or returns
+// an empty string for a valid one.
+type Check func(v protoreflect.Value) string
+
+// Rule validates one field of a message. A Required field need not be set
+// when the field named by Unless is.
+type Rule struct {
+	Field    string
+	Presence Presence
+	Unless   string
+	Checks   []Check
+}
+
+// Violation describes one invalid field.
+type Violation struct {
+	Field       string
+	Description string
+}
+
+func (v Violation) String() string {
+	return v.Field + " " + v.Description
+}
+
+// Message returns the violations of m against the rules of its type.
+// Messages without rules are valid.
+func Message(m proto.Message) []Violation {
+	msg := m.ProtoReflect()
+	rules := messageRules[msg.Descriptor().FullName()]
+	if len(rules) == 0 {
+		return nil
+	}
+	mask := updateMask(msg)
+
+	var violations []Violation
+	for _, r := range rules {
+		fd := msg.Descriptor().Fields().ByName(protoreflect.Name(r.Field))
+		if fd == nil {
+			continue
+		}
+		if !msg.Has(fd) {
+			if (r.Presence == Required && !has(msg, r.Unless)) || (r.Presence == RequiredInMask && mask.lists(r.Field)) {
+				violations = append(violations, Violation{Field: r.Field, Description: "is required"})
+			}
+			continue
+		}
+		for _, check := range r.Checks {
+			if desc := check(msg.Get(fd)); desc != "" {
+				violations = append(violations, Violation{Field: r.Field, Description: desc})
+				break
+			}
+		}
+	}
+	return violations
+}
+
+// has reports whether msg sets the named field.
+func has(msg protoreflect.Message, field string) bool {
+	fd := msg.Descriptor().Fields().ByName(protoreflect.Name(field))
+	return fd != nil && msg.Has(fd)
+}
+
+type paths map[string]bool
+
+func (p paths) lists(field string) bool {
+	return p["*"] || p[field]
+}
+
+// updateMask returns the paths of the update_mask of msg, if it has one.
+func updateMask(msg protoreflect.Message) paths {
+	fd := msg.Descriptor().Fields().ByName("update_mask")
+	if fd == nil || !msg.Has(fd) {
+		return nil
+	}
+	fm, ok := msg.Get(fd).Message().Interface().(*fieldmaskpb.FieldMask)
+	if !ok {
+		return nil
+	}
+	p := make(paths, len(fm.GetPaths()))
+	for _, path := range fm.GetPaths() {
+		p[path] = true
+	}
+	return p
+}
+
+// MaxLength limits a string to n characters.
+func MaxLength(n int) Check {
+	return func(v protoreflect.Value) string {
+		if utf8.RuneCountInString(v.String()) > n {
+			return fmt.Sprintf("must be at most %d characters", n)
+		}
+		return ""
+	}
+}
+
+// Min and Max bound an integer.
+func Min(n int64) Check {
+	return func(v protoreflect.Value) string {
+		if v.Int() < n {
+			return fmt.Sprintf("must be at least %d", n)
+		}
+		return ""
+	}
+}
+
+func Max(n int64) Check {
+	return func(v protoreflect.Value) string {
+		if v.Int() > n {
+			return fmt.Sprintf("must be at most %d", n)
+		}
+		return ""
+	}
+}
+
+// Email accepts a bare address such as ada@example.com.
+func Email(v protoreflect.Value) string {
+	addr, err := mail.ParseAddress(v.String())
+	if err != nil || addr.Address != v.String() {
+		return "must be an email address"
+	}
+	return ""
+}
+
+var e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// Phone accepts an E.164 number such as +14155550123.
+func Phone(v protoreflect.Value) string {
+	if !e164Pattern.MatchString(v.String()) {
+		return "must be an E.164 phone number such as +14155550123"
+	}
+	return ""
+}
+
+// Date accepts a YYYY-MM-DD date.
+func Date(v protoreflect.Value) string {
+	if _, err := time.Parse(time.DateOnly, v.String()); err != nil {
+		return "must be a YYYY-MM-DD date"
+	}
+	return ""
+}
+
+// OneOf accepts only the given strings.
+func OneOf(values ...string) Check {
+	return func(v protoreflect.Value) string {
+		for _, s := range values {
+			if v.String() == s {
+				return ""
+			}
+		}
+		return "must be one of " + strings.Join(values, ", ")
+	}
+}
@@ -0,0 +1,74 @@
+package validate
+
+import (
+	"testing"
+
+	adminpb "persacc/api/v1/admin"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func fields(violations []Violation) []string {
+	var f []string
+	for _, v := range violations {
+		f = append(f, v.Field)
+	}
+	return f
+}
+
+func TestMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		req  proto.Message
+		want []string
+	}{
+		{"valid product", &adminpb.CreateProductRequest{Sku: "CH-1", Name: "Chair"}, nil},
+		{"empty product", &adminpb.CreateProductRequest{}, []string{"sku", "name"}},
+		{"generated sku", &adminpb.CreateProductRequest{Name: "Chair", GenerateSku: true}, nil},
+		{"bad sku pattern", &adminpb.UpdateOrganizationRequest{Id: 1, SkuPattern: "PRD", Version: 1}, []string{"sku_pattern"}},
+		{"bad birthday", &adminpb.CreateCustomerRequest{Name: "Ada", Birthday: "12/10/1815"}, []string{"birthday"}},
+		{"bad phone", &adminpb.CreateCustomerRequest{Name: "Ada", Phone: "555-0123"}, []string{"phone"}},
+		{"bad email", &adminpb.CreateUserRequest{Email: "Ada <ada@example.com>"}, []string{"email"}},
+		{"large limit", &adminpb.ListProductsRequest{Limit: 1000}, []string{"limit"}},
+		{"negative page", &adminpb.ListVendorsRequest{Page: -1}, []string{"page"}},
+		{"unmasked update", &adminpb.UpdateProductRequest{Id: 1, Version: 1}, nil},
+		{"update without version", &adminpb.UpdateProductRequest{Id: 1}, []string{"version"}},
+		{"delete without version", &adminpb.DeleteVendorRequest{Id: 1}, []string{"version"}},
+		{"masked update", &adminpb.UpdateProductRequest{Id: 1, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}}, Version: 1}, []string{"name"}},
+		{"unknown role", &adminpb.AddOrganizationUserRequest{OrganizationId: 1, UserId: 2, Role: "owner"}, []string{"role"}},
+	}
+	for _, tt := range tests {
+		got := fields(Message(tt.req))
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: violations %v, want %v", tt.name, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: violations %v, want %v", tt.name, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+// Rules naming a field the message lacks would be skipped silently.
+func TestRulesNameMessageFields(t *testing.T) {
+	for name, rules := range messageRules {
+		mt, err := protoregistry.GlobalTypes.FindMessageByName(name)
+		if err != nil {
+			t.Errorf("no message %s", name)
+			continue
+		}
+		for _, r := range rules {
+			for _, field := range []string{r.Field, r.Unless} {
+				if field != "" && mt.Descriptor().Fields().ByName(protoreflect.Name(field)) == nil {
+					t.Errorf("%s has no field %s", name, field)
+				}
+			}
+		}
+	}
+}